@@ -0,0 +1,166 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ActionItem is a remediation task spawned by an RCA, kept in RCAData.Actions
+// keyed by A1, A2, ...
+type ActionItem struct {
+	Text      string
+	Owner     string
+	DueDate   string
+	Done      bool
+	CreatedBy string
+	CreatedAt int64
+	DoneBy    string
+	DoneAt    int64
+}
+
+func (v RCAData) OpenActionCount() int {
+	count := 0
+	for _, a := range v.Actions {
+		if !a.Done {
+			count++
+		}
+	}
+
+	return count
+}
+
+// SortedActionIDs returns action IDs in creation order (A1, A2, ... A10).
+func SortedActionIDs(actions map[string]ActionItem) []string {
+	ids := []string{}
+	for k := range actions {
+		ids = append(ids, k)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		ni, _ := strconv.Atoi(strings.TrimPrefix(ids[i], "A"))
+		nj, _ := strconv.Atoi(strings.TrimPrefix(ids[j], "A"))
+		if ni != nj {
+			return ni < nj
+		}
+		return ids[i] < ids[j]
+	})
+
+	return ids
+}
+
+func AddAction(uname, channelID, text string) (string, error) {
+	tokens, err := TokenizeArgs(text)
+	if err != nil {
+		return "", err
+	}
+
+	pops := PositionalArgs(tokens)
+	if len(pops) < 3 || pops[1] == "" {
+		return "", MsgError("action.add_invalid")
+	}
+
+	due := ""
+	if len(pops) > 3 {
+		if due, err = ParseDueDate(pops[3]); err != nil {
+			return "", err
+		}
+	}
+
+	issueID, v, err := FindRCA(channelID, pops[0])
+	if err != nil {
+		return "", err
+	}
+
+	if v.Title == "" {
+		return "", MsgError("rca.invalid_id", pops[0], Msg("action.add_action"), uname)
+	}
+
+	actionID := ""
+	err = Store.UpdateRCA(channelID, issueID, func(data *RCAData) error {
+		if data.Title == "" {
+			return ErrRCANotFound
+		}
+
+		if data.Actions == nil {
+			data.Actions = map[string]ActionItem{}
+		}
+
+		for n := len(data.Actions) + 1; ; n++ {
+			actionID = fmt.Sprintf("A%d", n)
+			if _, ok := data.Actions[actionID]; !ok {
+				break
+			}
+		}
+
+		data.Actions[actionID] = ActionItem{
+			Text:      pops[1],
+			Owner:     pops[2],
+			DueDate:   due,
+			CreatedBy: uname,
+			CreatedAt: time.Now().Unix(),
+		}
+		return nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	RecordRCANote(channelID, issueID, uname, "/addaction", "action_added", actionID, pops[1], pops[2])
+	return T(ChannelLanguage(channelID), "action.added", pops[1], actionID, v.Title, v.DisplayID(issueID), pops[2], uname), nil
+}
+
+func DoneAction(uname, channelID, text string) (string, error) {
+	desc := strings.Fields(text)
+
+	if len(desc) < 2 {
+		return "", MsgError("action.done_invalid")
+	}
+
+	actionID := strings.ToUpper(desc[1])
+
+	issueID, v, err := FindRCA(channelID, desc[0])
+	if err != nil {
+		return "", err
+	}
+
+	if v.Title == "" {
+		return "", MsgError("rca.invalid_id", desc[0], Msg("action.done_action"), uname)
+	}
+
+	actionText := ""
+	err = Store.UpdateRCA(channelID, issueID, func(data *RCAData) error {
+		a, ok := data.Actions[actionID]
+		if !ok {
+			return MsgError("action.invalid_id", actionID, data.Title, data.DisplayID(issueID))
+		}
+
+		if a.Done {
+			return MsgError("action.already_done", a.Text, actionID)
+		}
+
+		a.Done = true
+		a.DoneBy = uname
+		a.DoneAt = time.Now().Unix()
+		data.Actions[actionID] = a
+		actionText = a.Text
+		return nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	RecordRCANote(channelID, issueID, uname, "/doneaction", "action_done", actionID)
+
+	lang := ChannelLanguage(channelID)
+	msg := T(lang, "action.done", actionText, actionID, v.Title, v.DisplayID(issueID), uname)
+	if open := v.OpenActionCount() - 1; open > 0 {
+		msg += T(lang, "action.still_open", open)
+	}
+
+	return msg, nil
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,12 +16,7 @@ import (
 
 	cron "github.com/robfig/cron/v3"
 
-	"github.com/google/uuid"
 	"github.com/julienschmidt/httprouter"
-
-	firebase "firebase.google.com/go"
-	"firebase.google.com/go/db"
-	"google.golang.org/api/option"
 )
 
 type Cron struct {
@@ -43,11 +37,14 @@ var (
 )
 
 var (
-	FirebaseClient *db.Client
+	Store RCAStore
 )
 
 const (
-	MaxSlackDoneRCA = 15
+	MaxSlackListRCA = 15 //items per page of every list
+
+	MaxSlackSectionText = 3000 //Slack rejects a longer section text
+	MaxSlackBlocks      = 50   //and a message with more blocks
 )
 
 type Job struct {
@@ -59,18 +56,38 @@ type API struct {
 }
 
 type Channel struct {
-	ChannelKey string
-	Footer     string
-	Data       map[string]RCAData
+	ChannelKey string             `json:"channelKey"`
+	Footer     string             `json:"Footer"`
+	Data       map[string]RCAData `json:"data"`
+	Seq        int                `json:"seq"`
+	Alias      map[string]string  `json:"alias"`
+	Language   string             `json:"language"`
 }
 
 type RCAData struct {
 	Assignee    string
 	Description string
 	Environment string
-	Status      int
+	Status      RCAStatus
 	Title       string
 	PMA         string
+	PrevStatus  RCAStatus
+	RemovedAt   int64
+	DoneAt      int64
+
+	Severity       int
+	AffectedUsers  string
+	Duration       string
+	BusinessImpact string
+
+	DueDate      string
+	SnoozedUntil string //first day the item is reminded again
+	Tags         []string
+
+	Actions map[string]ActionItem
+
+	ShortID   string
+	CreatedAt int64
 }
 
 type Response struct {
@@ -93,8 +110,18 @@ type FBCred struct {
 
 func main() {
 
-	webserver, fbClient := initConfigAndModules()
-	FirebaseClient = fbClient
+	if IsCLICommand(os.Args[1:]) {
+		if err := RunCLI(os.Args[1:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	webserver, store := initConfigAndModules()
+	Store = store
+
+	AssignLegacyShortIDs()
 
 	cronM = &Cron{
 		listenErrCh: make(chan error),
@@ -107,9 +134,13 @@ func main() {
 	}
 	httpClient = &http.Client{Transport: tr}
 
+	InitSlackAPI()
+
 	RegisterCron()
 	RegisterReloadCron()
 	RegisterHeartBeatCron()
+	RegisterPurgeCron()
+	RegisterOverdueCron()
 
 	webserver.Run()
 }
@@ -123,7 +154,7 @@ func RegisterReloadCron() {
 	c.register(Job{
 		Interval: "25 * * * *", //hourly
 		Handler: func() {
-			Println(nil, "JALAN DONG: ", cronJobID)
+			Println(nil, "RELOAD CRON, running jobs: ", cronJobID)
 			RegisterCron()
 		},
 	})
@@ -177,12 +208,16 @@ func RegisterCron() {
 			Println(nil, "PROCESS CRON GET RCA DATA ERROR, err: ", err)
 		}
 
+		//every job keeps its own channel, the loop variables are reused
+		channelID, v := channelID, v
 		cronM.register(
 			Job{
 				Interval: interval,
 				Handler: func() {
-					msg := ConstructRCADataString(v, 0, "")
-					NotifySlack(msg, v.ChannelKey)
+					msg := ConstructRCADataString(v, StatusOpen, "", 1, "")
+					if err := NotifySlack(msg, channelID, v.ChannelKey); err != nil {
+						Println(nil, "PROCESS CRON NOTIFY SLACK ERROR, err: ", err)
+					}
 				},
 			})
 	}
@@ -207,7 +242,7 @@ func (c Cron) RemoveAllRunningCron(ids []cron.EntryID, task *cron.Cron) []cron.E
 	return []cron.EntryID{}
 }
 
-func initConfigAndModules() (*WebServer, *db.Client) {
+func initConfigAndModules() (*WebServer, RCAStore) {
 	cfgWeb := &Option{
 		Environment: "development",
 		Domain:      "",
@@ -216,34 +251,16 @@ func initConfigAndModules() (*WebServer, *db.Client) {
 
 	webserver := NewWeb(cfgWeb)
 
-	ctx := context.Background()
-	conf := &firebase.Config{
-		DatabaseURL: os.Getenv("DB_URL"),
-	}
-
-	cred := os.Getenv("FB_CRED")
-
-	var credData FBCred
-	json.Unmarshal([]byte(cred), &credData)
-	file, _ := json.MarshalIndent(credData, "", " ")
-	ioutil.WriteFile("./secret.json", file, 0644)
-
-	opt := option.WithCredentialsFile("./secret.json")
-	app, err := firebase.NewApp(ctx, conf, opt)
-
-	if err != nil {
-		panic(fmt.Sprintf("firebase.NewApp: %v", err))
-	}
-	fbClient, err := app.Database(ctx)
+	store, err := NewRCAStore(os.Getenv("STORE_BACKEND"))
 	if err != nil {
-		panic(fmt.Sprintf("app.Firestore: %v", err))
+		panic(fmt.Sprintf("NewRCAStore: %v", err))
 	}
 
 	webserver.RegisterAPI(
 		API{},
 	)
 
-	return webserver, fbClient
+	return webserver, store
 }
 
 func (api API) Register(router *httprouter.Router) {
@@ -267,11 +284,20 @@ func (api API) HandleHeartbeat(w http.ResponseWriter, r *http.Request, p httprou
 }
 
 type InteractivePayload struct {
-	User        InteractiveUserData     `json:"user"`
-	Channel     InteractiveChannelData  `json:"channel"`
-	ResponseURL string                  `json:"response_url"`
-	Type        string                  `json:"type"`
-	Action      []InteractiveActionData `json:"actions"`
+	User        InteractiveUserData      `json:"user"`
+	Channel     InteractiveChannelData   `json:"channel"`
+	Container   InteractiveContainerData `json:"container"`
+	ResponseURL string                   `json:"response_url"`
+	TriggerID   string                   `json:"trigger_id"`
+	Type        string                   `json:"type"`
+	Action      []InteractiveActionData  `json:"actions"`
+	View        ViewPayload              `json:"view"`
+}
+
+type InteractiveContainerData struct {
+	Type      string `json:"type"`
+	MessageTS string `json:"message_ts"`
+	ChannelID string `json:"channel_id"`
 }
 
 type InteractiveUserData struct {
@@ -292,6 +318,18 @@ type InteractiveActionData struct {
 	Value    string       `json:"value"`
 	Type     string       `json:"type"`
 	ActionTS string       `json:"action_ts"`
+
+	SelectedOption *ViewOption `json:"selected_option"`
+}
+
+// Target is the action_id and the value the action runs with, a select or
+// overflow menu carries both in the picked option.
+func (a InteractiveActionData) Target() (string, string) {
+	if (a.Type == "static_select" || a.Type == "overflow") && a.SelectedOption != nil {
+		return ParseOverflowValue(a.SelectedOption.Value)
+	}
+
+	return a.ActionID, a.Value
 }
 
 func (api API) HandleInteractive(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
@@ -300,60 +338,49 @@ func (api API) HandleInteractive(w http.ResponseWriter, r *http.Request, p httpr
 	pload := r.FormValue("payload")
 
 	err := json.Unmarshal([]byte(pload), &payload)
-	if err != nil || payload.Channel.ID == "" || payload.User.Username == "" || len(payload.Action) == 0 || payload.Action[0].Value == "" || payload.Action[0].Text.Text == "" {
+	if err == nil && payload.Type == "view_submission" {
+		HandleViewSubmission(w, payload)
+		return
+	}
+
+	actionID, value := "", ""
+	if len(payload.Action) > 0 {
+		actionID, value = payload.Action[0].Target()
+	}
+
+	if err != nil || payload.Channel.ID == "" || payload.User.Username == "" || actionID == "" || value == "" {
 		Printf(nil, "[Custom Binary] Interactive Payload decode error: %+v, request: %+v", err, r)
 		resp := Response{
 			ResponseType: "ephemeral",
-			Text:         fmt.Sprintf("Sorry System Error: Bad Payload :("),
+			Text:         T(ChannelLanguage(payload.Channel.ID), "error.bad_payload"),
 		}
 
 		WriteResponse(w, resp)
 		return
 	}
 
-	channelID := payload.Channel.ID
-	command := payload.Action[0].Text.Text
-	value := payload.Action[0].Value
-	uname := payload.User.Username
-
-	directChannelKey := ""
-	directMsg := ""
-
-	tempSlackMsg := SlackMsgStructure{}
-	channelData := Channel{}
-
-	if WebhookRequiredCommand(command) {
-		channelData, err = GetRCAData(channelID)
-
-		if err == nil && channelData.ChannelKey == "" {
-			err = errors.New("Channel Webhook not set, set using command /setslackwebhook [webhook_key]")
-		} else if err == nil {
-			directChannelKey = channelData.ChannelKey
-		}
-	}
-
-	if err == nil {
-		if command == "Set Done" {
-			directMsg, err = DoneRCA(uname, value, channelID, 1)
-		}
-	}
-
-	if err != nil {
+	action, ok := FindAction(actionID, payload.Action[0].Text.Text)
+	if !ok {
 		resp := Response{
 			ResponseType: "ephemeral",
-			Text:         err.Error(),
+			Text:         T(ChannelLanguage(payload.Channel.ID), "error.unknown_action", actionID),
 		}
 		WriteResponse(w, resp)
 		return
 	}
 
-	if directMsg != "" {
-		tempblock := GetSlackMessageStructure(directMsg)
-		tempSlackMsg.Blocks = append(tempSlackMsg.Blocks, tempblock)
-		NotifySlack(tempSlackMsg, directChannelKey)
-		return
-	}
+	result, err := ExecuteCommand(action, CommandContext{
+		UserName:    payload.User.Username,
+		UserID:      payload.User.ID,
+		ChannelID:   payload.Channel.ID,
+		Command:     action.Name,
+		Text:        value,
+		MessageTS:   payload.Container.MessageTS,
+		ResponseURL: payload.ResponseURL,
+		TriggerID:   payload.TriggerID,
+	})
 
+	WriteCommandResult(w, result, err)
 }
 
 func (api API) HandleCommand(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
@@ -361,7 +388,7 @@ func (api API) HandleCommand(w http.ResponseWriter, r *http.Request, p httproute
 	command := r.FormValue("command")
 	text := r.FormValue("text")
 	uname := r.FormValue("user_name")
-	//responseUrl := r.FormValue("response_url")
+	responseURL := r.FormValue("response_url")
 
 	if command == "" || channelID == "" || uname == "" {
 		resp := Response{
@@ -374,53 +401,32 @@ func (api API) HandleCommand(w http.ResponseWriter, r *http.Request, p httproute
 		return
 	}
 
-	var err error
-	directMsg := ""
-	ephemeralMsg := ""
-	directChannelKey := ""
-
-	tempSlackMsg := SlackMsgStructure{}
-	channelData := Channel{}
-
-	if WebhookRequiredCommand(command) {
-		channelData, err = GetRCAData(channelID)
-
-		if err == nil && channelData.ChannelKey == "" {
-			err = errors.New("Channel Webhook not set, set using command /setslackwebhook [webhook_key]")
-		} else if err == nil {
-			directChannelKey = channelData.ChannelKey
+	cmd, ok := FindCommand(command)
+	if !ok {
+		resp := Response{
+			ResponseType: "ephemeral",
+			Text:         T(ChannelLanguage(channelID), "error.unknown_command", command),
 		}
+		WriteResponse(w, resp)
+		return
 	}
 
-	if err == nil {
-		if command == "/listrca" {
-			tempSlackMsg = ConstructRCADataString(channelData, 0, uname)
-		} else if command == "/listdonerca" {
-			tempSlackMsg = ConstructRCADataString(channelData, 1, uname)
-		} else if command == "/addrca" {
-			directMsg, err = AddRCA(uname, text, channelID)
-		} else if command == "/donerca" {
-			directMsg, err = DoneRCA(uname, text, channelID, 1)
-		} else if command == "/removerca" {
-			directMsg, err = DoneRCA(uname, text, channelID, 3)
-		} else if command == "/doneallrca" {
-			directMsg, err = DoneAllRCA(uname, channelID, channelData)
-		} else if command == "/setscheduler" {
-			directMsg, err = SetScheduler(uname, channelID, text)
-		} else if command == "/removescheduler" {
-			directMsg, err = RemoveScheduler(uname, channelID)
-		} else if command == "/setslackwebhook" {
-			directMsg, err = SetWebhook(uname, channelID, text)
-			directChannelKey = text
-		} else if command == "/setfooter" {
-			directMsg, err = SetFooter(uname, channelID, text)
-		} else if command == "/setpma" {
-			directMsg, err = SetPMA(uname, channelID, text)
-		} else if command == "/internalrcahelp" {
-			ephemeralMsg = HelpRCA()
-		}
-	}
+	result, err := ExecuteCommand(cmd, CommandContext{
+		UserName:    uname,
+		UserID:      r.FormValue("user_id"),
+		ChannelID:   channelID,
+		Command:     command,
+		Text:        text,
+		ResponseURL: responseURL,
+		TriggerID:   r.FormValue("trigger_id"),
+	})
+
+	WriteCommandResult(w, result, err)
+}
 
+// WriteCommandResult replies with the error or ephemeral message, Blocks and
+// Message go out as the command's ResponseType says.
+func WriteCommandResult(w http.ResponseWriter, result CommandResult, err error) {
 	if err != nil {
 		resp := Response{
 			ResponseType: "ephemeral",
@@ -430,28 +436,23 @@ func (api API) HandleCommand(w http.ResponseWriter, r *http.Request, p httproute
 		return
 	}
 
-	if len(tempSlackMsg.Blocks) > 0 {
-		NotifySlack(tempSlackMsg, directChannelKey)
+	tempSlackMsg := SlackMsgStructure{}
+
+	if len(result.Blocks.Blocks) > 0 {
+		PostCommandResult(w, result, result.Blocks)
 		return
 	}
 
-	if directMsg != "" {
-		tempblock := GetSlackMessageStructure(directMsg)
-		tempSlackMsg.Blocks = append(tempSlackMsg.Blocks, tempblock)
-		NotifySlack(tempSlackMsg, directChannelKey)
+	if result.Message != "" {
+		tempSlackMsg.Blocks = GetSlackTextBlocks(strings.Split(result.Message, "\n"))
+		PostCommandResult(w, result, tempSlackMsg)
 		return
 	}
 
-	if ephemeralMsg != "" {
-		tempblock := GetSlackMessageStructure(ephemeralMsg)
-		tempSlackMsg.Blocks = append(tempSlackMsg.Blocks, tempblock)
+	if result.Ephemeral != "" {
+		tempSlackMsg.Blocks = GetSlackTextBlocks(strings.Split(result.Ephemeral, "\n"))
 		WriteResponse(w, tempSlackMsg)
 	}
-
-}
-
-func WebhookRequiredCommand(command string) bool {
-	return command != "/internalrcahelp" && command != "/setslackwebhook"
 }
 
 func WriteResponse(w http.ResponseWriter, response interface{}) {
@@ -462,21 +463,84 @@ func WriteResponse(w http.ResponseWriter, response interface{}) {
 	w.Write(b)
 }
 
-func NotifySlack(message SlackMsgStructure, channelKey string) error {
+// PostCommandResult sends Blocks or Message out and tells the caller when
+// Slack refused it. Replace results update the clicked message, ephemeral ones
+// go to the response_url, in_channel ones go through the webhook, then the
+// response_url, then the bot token.
+func PostCommandResult(w http.ResponseWriter, result CommandResult, message SlackMsgStructure) {
+	if result.Replace {
+		err := ReplaceSlackMessage(result, message)
+		if err == nil {
+			return
+		}
+		Println(nil, "REPLACE SLACK MESSAGE ERROR, posting instead, err: ", err)
+	}
+
+	if result.ResponseType == ResponseEphemeral && result.ResponseURL == "" {
+		WriteResponse(w, SlackResponse{ResponseType: ResponseEphemeral, Blocks: message.Blocks})
+		return
+	}
+
+	if err := SendCommandResult(result, message); err != nil {
+		resp := Response{
+			ResponseType: "ephemeral",
+			Text:         T(ChannelLanguage(result.ChannelID), "error.post_failed", err),
+		}
+		WriteResponse(w, resp)
+	}
+}
+
+// SendCommandResult posts through the response_url, or the webhook and then
+// the bot token for in_channel results.
+func SendCommandResult(result CommandResult, message SlackMsgStructure) error {
+	if result.ResponseURL != "" && (result.ResponseType == ResponseEphemeral || result.ChannelKey == "") {
+		return RespondSlack(result.ResponseURL, SlackResponse{ResponseType: result.ResponseType, Blocks: message.Blocks})
+	}
+
+	return NotifySlack(message, result.ChannelID, result.ChannelKey)
+}
+
+// ReplaceSlackMessage updates the message a button was clicked on, through
+// the response_url or else the bot token.
+func ReplaceSlackMessage(result CommandResult, message SlackMsgStructure) error {
+	if result.ResponseURL != "" {
+		return RespondSlack(result.ResponseURL, SlackResponse{ReplaceOriginal: true, Blocks: message.Blocks})
+	}
+
+	if result.MessageTS == "" || slackAPI == nil {
+		return errors.New("no response_url or bot token to update the message with")
+	}
+
+	return slackAPI.UpdateMessage(result.ChannelID, result.MessageTS, message)
+}
+
+// RespondSlack replies through the response_url of a slash command or an
+// interaction, Slack accepts up to 5 replies within 30 minutes.
+func RespondSlack(responseURL string, resp SlackResponse) error {
+	slackM := NewSlackModule(responseURL, "Production")
+	return slackM.PublishResponse(resp)
+}
+
+// NotifySlack posts through the channel webhook when one is set, otherwise
+// with the bot token.
+func NotifySlack(message SlackMsgStructure, channelID, channelKey string) error {
+	if channelKey == "" && slackAPI != nil {
+		_, err := slackAPI.PostMessage(channelID, message)
+		return err
+	}
 
 	slackM := NewSlackModule(channelKey, "Production")
-	slackM.PublishSlack(message)
-	return nil
+	return slackM.PublishSlack(message)
 }
 
-func HelpRCA() string {
-	return "*Internal RCA BOT Command Help*\n\n• `/listrca` - Get List Active RCA :memo::memo:\n• `/listdonerca` - Get list of Done RCA\n• `/addrca - (Title) (Desc) Assignee [PMATicketURL] [Staging|Production]` - Add New RCA, `use parentheses` for multi space text. *Sample*: (title multi) (desc multi) assignee pma staging\n• `/removerca issueID` - Remove RCA\n• `/donerca issueID` - Set RCA to Done\n• `/doneallrca` - *Done all* active RCA :warning::warning:\n• `/setpma issueID PMATicketURL` - Set PMA Ticket for issue\n• `/setscheduler schedule` (*<https://pkg.go.dev/github.com/robfig/cron/v3|format>*) - Set Scheduler for RCA List\n• `/setslackwebhook webhook_key` - Set slack webhook for scheduler (*for the webhook url*, contact: <@U75J4HEF9>)\n• `/removescheduler` - Remove Scheduler for RCA List \n• `/setfooter text` - Set *Custom* footer notes that shown at the bottom of RCA List\n• `/internalrcahelp` - Command list for RCA & Sharing Bot"
+// CanPostTo reports whether messages to the channel have a way out.
+func CanPostTo(ch Channel) bool {
+	return ch.ChannelKey != "" || slackAPI != nil
 }
 
 func RemoveScheduler(uname, channelID string) (string, error) {
-	ctx := context.Background()
-	msg := fmt.Sprintf("_RCA List scheduler removed by %s_", uname)
-	err := FirebaseClient.NewRef(fmt.Sprintf("Scheduler/%s", channelID)).Delete(ctx)
+	msg := T(ChannelLanguage(channelID), "scheduler.removed", uname)
+	err := Store.RemoveSchedule(channelID)
 
 	if err == nil {
 		RegisterCron()
@@ -489,17 +553,11 @@ func SetScheduler(uname, channelID, text string) (string, error) {
 
 	desc := strings.Split(text, " ")
 	if len(desc) != 5 {
-		return "", errors.New("Format scheduler invalid sample: 0 14 * * * (*<https://pkg.go.dev/github.com/robfig/cron/v3|format>*)")
+		return "", MsgError("scheduler.invalid")
 	}
 
-	ctx := context.Background()
-
-	updateTxn := func(node db.TransactionNode) (interface{}, error) {
-		return text, nil
-	}
-
-	msg := fmt.Sprintf("_RCA List scheduler set to %s by %s_", text, uname)
-	err := FirebaseClient.NewRef(fmt.Sprintf("Scheduler/%s", channelID)).Transaction(ctx, updateTxn)
+	msg := T(ChannelLanguage(channelID), "scheduler.set", text, uname)
+	err := Store.SetSchedule(channelID, text)
 
 	if err == nil {
 		RegisterCron()
@@ -510,166 +568,207 @@ func SetScheduler(uname, channelID, text string) (string, error) {
 }
 
 func SetFooter(uname, channelID, text string) (string, error) {
-	ctx := context.Background()
-
-	updateTxn := func(node db.TransactionNode) (interface{}, error) {
-		return text, nil
-	}
-
-	return fmt.Sprintf("_Message RCA - updated to %s by %s_", strings.Replace(text, "\n", "", -1), uname), FirebaseClient.NewRef(fmt.Sprintf("Channel/%s/Footer", channelID)).Transaction(ctx, updateTxn)
+	return T(ChannelLanguage(channelID), "footer.set", strings.Replace(text, "\n", "", -1), uname), Store.SetFooter(channelID, text)
 }
 
 func SetWebhook(uname, channelID, text string) (string, error) {
-	ctx := context.Background()
-
 	if text == "" {
-		return "", errors.New("Webhook URL Not Present")
-	}
-
-	updateTxn := func(node db.TransactionNode) (interface{}, error) {
-		return text, nil
+		return "", MsgError("webhook.missing")
 	}
 
-	return fmt.Sprintf("_Slack Webook set by %s_", uname), FirebaseClient.NewRef(fmt.Sprintf("Channel/%s/channelKey", channelID)).Transaction(ctx, updateTxn)
+	return T(ChannelLanguage(channelID), "webhook.set", uname), Store.SetChannelKey(channelID, text)
 }
 
 func DoneAllRCA(uname, channelID string, channelData Channel) (string, error) {
 
-	for issueID, _ := range channelData.Data {
-		err := SetDoneRCAData(channelID, issueID, 1)
+	skipped := []string{}
+
+	for issueID, is := range channelData.Data {
+		if !is.Status.IsActive() {
+			continue
+		}
+
+		if is.OpenActionCount() > 0 {
+			skipped = append(skipped, fmt.Sprintf("%s (`%s`)", is.Title, is.DisplayID(issueID)))
+			continue
+		}
+
+		err := SetRCAStatus(uname, "/doneallrca", channelID, issueID, StatusDone, false)
 		if err != nil {
 			return "", err
 		}
 	}
 
-	return fmt.Sprintf("_All RCA Set to Done by %s_", uname), nil
-}
+	lang := ChannelLanguage(channelID)
+	if len(skipped) > 0 {
+		sort.Strings(skipped)
+		return T(lang, "doneall.done", uname) + "\n" + T(lang, "doneall.skipped", strings.Join(skipped, ", ")), nil
+	}
 
-func DoneRCA(uname, text, channelID string, status int) (string, error) {
-	desc := strings.Split(text, " ")
+	return T(lang, "doneall.done", uname), nil
+}
 
-	if len(desc) < 1 || desc[0] == "" {
-		return "", errors.New("Command invalid")
+// DoneRCA sets one RCA to Done or Removed, several IDs or a selector like
+// assignee=@budi go through BulkSetRCAStatus.
+func DoneRCA(uname, text, channelID string, status RCAStatus) (string, error) {
+	tokens, err := TokenizeArgs(text)
+	if err != nil {
+		return "", err
 	}
 
-	setTo := "Set to Done"
-	if status == 3 {
-		setTo = "Removed"
+	force := false
+	rest := []ArgToken{}
+	for _, t := range tokens {
+		if status == StatusDone && t.Key == "" && t.Value == "force" {
+			force = true
+			continue
+		}
+		rest = append(rest, t)
 	}
 
-	var v RCAData
-	ctx := context.Background()
-	err := FirebaseClient.NewRef(fmt.Sprintf("Channel/%s/data/%s", channelID, desc[0])).Get(ctx, &v)
-
+	targets, err := ParseBulkTargets(rest)
 	if err != nil {
 		return "", err
 	}
 
-	if v.Title == "" {
-		return "", errors.New(fmt.Sprintf("FAILED - Invalid RCA ID (%s) - Action: %s by %s", desc[0], setTo, uname))
+	if targets.IsEmpty() {
+		return "", MsgError("command.invalid")
 	}
 
-	return fmt.Sprintf("_RCA %s (`%s`) %s by %s_", v.Title, desc[0], setTo, uname), SetDoneRCAData(channelID, desc[0], status)
-}
+	if !targets.IsSingle() {
+		return BulkSetRCAStatus(uname, channelID, targets, status, force)
+	}
 
-func SetPMA(uname, channelID, text string) (string, error) {
-	desc := strings.Split(text, " ")
+	desc := targets.IDs
 
-	if len(desc) < 2 || desc[0] == "" || desc[1] == "" {
-		return "", errors.New("Command invalid")
+	setTo := Msg("action.set_done")
+	command := "/donerca"
+	if status == StatusRemoved {
+		setTo = Msg("action.remove")
+		command = "/removerca"
 	}
 
-	var v RCAData
-	ctx := context.Background()
-	err := FirebaseClient.NewRef(fmt.Sprintf("Channel/%s/data/%s", channelID, desc[0])).Get(ctx, &v)
+	issueID, v, err := FindRCA(channelID, desc[0])
 
 	if err != nil {
 		return "", err
 	}
 
 	if v.Title == "" {
-		return "", errors.New(fmt.Sprintf("FAILED - Invalid RCA ID (%s) - Action: Set PMA Ticket by %s", desc[0], uname))
+		return "", MsgError("rca.invalid_id", desc[0], setTo, uname)
 	}
 
-	updateTxn := func(node db.TransactionNode) (interface{}, error) {
-		return desc[1], nil
+	if err := SetRCAStatus(uname, command, channelID, issueID, status, force); err != nil {
+		return "", err
 	}
 
-	return fmt.Sprintf("_RCA %s (`%s`) PMA Ticket set by %s_", v.Title, desc[0], uname), FirebaseClient.NewRef(fmt.Sprintf("Channel/%s/data/%s/PMA", channelID, desc[0])).Transaction(ctx, updateTxn)
+	return T(ChannelLanguage(channelID), "status.done_removed", v.Title, v.DisplayID(issueID), setTo, uname), nil
 }
 
-func SetDoneRCAData(channelID, issueID string, status int) error {
-	ctx := context.Background()
+func SetPMA(uname, channelID, text string) (string, error) {
+	desc := strings.Split(text, " ")
 
-	updateTxn := func(node db.TransactionNode) (interface{}, error) {
-		return status, nil
+	if len(desc) < 2 || desc[0] == "" || desc[1] == "" {
+		return "", MsgError("command.invalid")
 	}
 
-	return FirebaseClient.NewRef(fmt.Sprintf("Channel/%s/data/%s/Status", channelID, issueID)).Transaction(ctx, updateTxn)
-}
+	issueID, v, err := FindRCA(channelID, desc[0])
 
-func AddRCA(uname, text, channelID string) (string, error) {
+	if err != nil {
+		return "", err
+	}
+
+	if v.Title == "" {
+		return "", MsgError("rca.invalid_id", desc[0], Msg("action.set_pma"), uname)
+	}
 
-	desc := strings.Split(text, ")")
-	pops := []string{}
+	oldPMA := ""
+	err = Store.UpdateRCA(channelID, issueID, func(data *RCAData) error {
+		if data.Title == "" {
+			return ErrRCANotFound
+		}
 
-	for _, v := range desc {
+		oldPMA = data.PMA
+		data.PMA = desc[1]
+		return nil
+	})
 
-		v = strings.Trim(v, " ")
+	if err == nil {
+		RecordRCAEvent(channelID, issueID, uname, "/setpma", "PMA", oldPMA, desc[1])
+	}
 
-		if strings.Contains(v, "(") {
-			// multi space
-			v = strings.Replace(v, "(", "", -1)
-			pops = append(pops, v)
-			continue
-		}
+	return T(ChannelLanguage(channelID), "pma.set", v.Title, v.DisplayID(issueID), uname), err
+}
 
-		spaces := strings.Split(v, " ")
-		pops = append(pops, spaces...)
+// SetPMAHint answers the Set PMA button, the link itself has to come from
+// /setpma since a button carries no input.
+func SetPMAHint(uname, channelID, text string) (string, error) {
+	issueID, v, err := FindRCA(channelID, text)
+	if err != nil {
+		return "", err
 	}
 
-	if len(pops) < 3 {
-		return "", errors.New("Command invalid, use parentheses (for multi space) *Sample*: (title multi) (desc multi) assignee pma staging")
+	if v.Title == "" {
+		return "", MsgError("rca.invalid_id", text, Msg("action.set_pma"), uname)
 	}
 
-	pma := "" //optional
-	if len(pops) > 3 {
-		pma = pops[3]
+	lang := ChannelLanguage(channelID)
+	current := T(lang, "pma.not_set")
+	if v.PMA != "" {
+		current = T(lang, "pma.current", v.PMA, v.PMA)
 	}
 
-	env := "Production" //optional
-	if len(pops) > 4 {
-		env = strings.Title(pops[4])
+	return T(lang, "pma.hint", v.Title, v.DisplayID(issueID), current, v.DisplayID(issueID)), nil
+}
+
+func ReassignHint(uname, channelID, text string) (string, error) {
+	issueID, v, err := FindRCA(channelID, text)
+	if err != nil {
+		return "", err
 	}
 
-	data := RCAData{
-		Assignee:    pops[2],
-		Description: pops[1],
-		Environment: env,
-		Status:      0,
-		Title:       pops[0],
-		PMA:         pma,
+	if v.Title == "" {
+		return "", MsgError("rca.invalid_id", text, Msg("action.reassign"), uname)
 	}
 
-	ctx := context.Background()
-	if err := FirebaseClient.NewRef(fmt.Sprintf("Channel/%s/data/%s", channelID, GetIssueID())).Set(ctx, data); err != nil {
+	return T(ChannelLanguage(channelID), "reassign.hint", v.Title, v.DisplayID(issueID), v.Assignee, v.DisplayID(issueID)), nil
+}
+
+func AddRCA(uname, text, channelID string) (string, error) {
+
+	data, err := ParseAddRCAArgs(text)
+	if err != nil {
 		return "", err
 	}
 
-	return fmt.Sprintf("_RCA %s Added by %s_", pops[0], uname), nil
+	return CreateRCA(uname, channelID, data)
 }
 
-func GetIssueID() string {
+// CreateRCA stores a parsed RCA under a new short ID.
+func CreateRCA(uname, channelID string, data RCAData) (string, error) {
+	issueID, err := NewIssueID(channelID)
+	if err != nil {
+		return "", err
+	}
 
-	str := fmt.Sprintf("%s", uuid.New())
-	splitted := strings.Split(str, "-")
+	data.ShortID = issueID
+	data.CreatedAt = time.Now().UnixNano()
 
-	length := 3
-	if len(splitted) < length {
-		length = len(splitted)
+	//never overwrite an item, e.g. when an import left the sequence behind
+	err = Store.UpdateRCA(channelID, issueID, func(v *RCAData) error {
+		if v.Title != "" {
+			return MsgError("store.rca_exists", issueID)
+		}
+		*v = data
+		return nil
+	})
+	if err != nil {
+		return "", err
 	}
 
-	return fmt.Sprint(time.Now().UnixNano(), "-", strings.Join(splitted[:length], "-"))
+	RecordRCANote(channelID, issueID, uname, "/addrca", "created", data.Assignee, data.Environment)
+
+	return T(ChannelLanguage(channelID), "rca.added", data.Title, issueID, uname), nil
 }
 
 func CaptureCronPanic(handler func()) func() {
@@ -739,60 +838,68 @@ func (c *Cron) Run(taskCron *cron.Cron, setToList bool) {
 }
 
 func GetRCAData(channelID string) (Channel, error) {
-	var v Channel
-	ctx := context.Background()
-	err := FirebaseClient.NewRef(fmt.Sprintf("Channel/%s", channelID)).Get(ctx, &v)
-	return v, err
+	return Store.GetChannel(channelID)
 }
 
-func ConstructRCADataString(v Channel, getStatus int, uname string) SlackMsgStructure {
+// ConstructRCADataString renders one list, StatusOpen means every active stage
+// grouped by stage inside each environment. Every list is paged, listArgs
+// are the list filters carried by the Previous/Next buttons.
+func ConstructRCADataString(v Channel, getStatus RCAStatus, uname string, page int, listArgs string) SlackMsgStructure {
 
+	lang := v.Lang()
 	title := ""
 	request := ""
 
 	if uname != "" {
-		request = fmt.Sprintf("_RCA List requested by %s_\n\n", uname)
+		request = T(lang, "list.requested", uname)
 	}
 
-	title = request + fmt.Sprintf("*Internal Sharing & RCA List*\n\n")
+	title = request + T(lang, "list.title")
 	staging := []BlockStructure{}
 	production := []BlockStructure{}
 
 	filteredKey := []string{}                  //issueID
 	mapBlockMsg := map[string]BlockStructure{} //issueID -> msg
 	mapEnvi := map[string]string{}
+	mapStage := map[string]RCAStatus{}
 
 	emot := "bangbang"
 
-	if getStatus == 1 {
-		title = request + fmt.Sprintf("*Internal Sharing & RCA List - DONE*\n\n")
+	if getStatus == StatusDone {
+		title = request + T(lang, "list.title_done")
 		emot = "white_check_mark"
 	}
 
+	if getStatus == StatusRemoved {
+		title = request + T(lang, "list.title_removed")
+		emot = "wastebasket"
+	}
+
 	issueKeys := []string{}
 	for k := range v.Data {
 		issueKeys = append(issueKeys, k)
 	}
-	sort.Strings(issueKeys)
+	SortIssueKeysByCreated(issueKeys, v.Data)
 
 	for i := len(issueKeys) - 1; i >= 0; i-- {
 
 		issueID := issueKeys[i]
 		is := v.Data[issueID]
 
-		Println(nil, issueID)
-
-		if is.Status != getStatus {
+		if getStatus == StatusOpen && !is.Status.IsActive() {
 			continue
 		}
 
-		pma := ""
+		if getStatus != StatusOpen && is.Status != getStatus {
+			continue
+		}
 
-		if is.PMA != "" {
-			pma = fmt.Sprintf("- <%s|*PMA*> ", is.PMA)
+		//the scheduled list leaves snoozed items out
+		if uname == "" && IsSnoozed(is, time.Now()) {
+			continue
 		}
 
-		access := GetSlackAccessory("Set Done", issueID)
+		access := GetRCAItemMenu(lang, issueID, is)
 		/*if is.Environment == "Staging" {
 			staging = append(staging, GetSlackMessageStructure(fmt.Sprintf(">\t:%s:  *%s* %s\n\t\t\t• `Issue ID:` %s\n\t\t\t• `Description:` %s\n\t\t\t• `Assignee:` %s\n\n", emot, is.Title, pma, issueID, is.Description, is.Assignee), access))
 		} else {
@@ -805,25 +912,81 @@ func ConstructRCADataString(v Channel, getStatus int, uname string) SlackMsgStru
 		}
 
 		filteredKey = append(filteredKey, issueID)
-		tempBlockStructure := GetSlackMessageStructure(fmt.Sprintf(">\t:%s:  *%s* %s\n\t\t\t• `Issue ID:` %s\n\t\t\t• `Description:` %s\n\t\t\t• `Assignee:` %s\n\n", emot, is.Title, pma, issueID, is.Description, is.Assignee), access)
+		tempBlockStructure := GetSlackMessageStructure(GetRCAItemText(lang, emot, issueID, is), access)
 		mapBlockMsg[issueID] = tempBlockStructure
 		mapEnvi[issueID] = envi
-	}
+		mapStage[issueID] = is.Status
+	}
+
+	//the active list pages the most severe first, the others the latest first
+	listCommand := "/listrca"
+	switch getStatus {
+	case StatusDone:
+		listCommand = "/listdonerca"
+		sort.SliceStable(filteredKey, func(i, j int) bool {
+			return RCADoneAt(filteredKey[i], v.Data[filteredKey[i]]).After(RCADoneAt(filteredKey[j], v.Data[filteredKey[j]]))
+		})
+	case StatusRemoved:
+		listCommand = "/listremovedrca"
+		sort.SliceStable(filteredKey, func(i, j int) bool {
+			return v.Data[filteredKey[i]].RemovedAt > v.Data[filteredKey[j]].RemovedAt
+		})
+	default:
+		sort.SliceStable(filteredKey, func(i, j int) bool {
+			return SeverityRank(v.Data[filteredKey[i]].Severity) < SeverityRank(v.Data[filteredKey[j]].Severity)
+		})
+	}
+
+	var nav *BlockStructure
+	if pages := (len(filteredKey) + MaxSlackListRCA - 1) / MaxSlackListRCA; pages > 1 {
+		if page > pages {
+			page = pages
+		}
+		if page < 1 {
+			page = 1
+		}
+
+		title = strings.TrimSuffix(title, "\n\n") + T(lang, "list.page", page, pages, len(filteredKey)) + "\n\n"
 
-	if getStatus == 1 && len(filteredKey) > MaxSlackDoneRCA {
-		title = request + fmt.Sprintf("*Internal Sharing & RCA List - DONE - Last 15 %d*\n\n", MaxSlackDoneRCA)
-		filteredKey = filteredKey[:MaxSlackDoneRCA]
+		end := page * MaxSlackListRCA
+		if end > len(filteredKey) {
+			end = len(filteredKey)
+		}
+		filteredKey = filteredKey[(page-1)*MaxSlackListRCA : end]
+
+		block := GetPageNavBlock(lang, listCommand, page, pages, listArgs)
+		nav = &block
 	}
 
+	sort.SliceStable(filteredKey, func(i, j int) bool {
+		return SeverityRank(v.Data[filteredKey[i]].Severity) < SeverityRank(v.Data[filteredKey[j]].Severity)
+	})
+
+	stagingKey := []string{}
+	productionKey := []string{}
+
 	for _, key := range filteredKey {
 		envi := mapEnvi[key]
 
 		if envi == "Staging" {
-			staging = append(staging, mapBlockMsg[key])
+			stagingKey = append(stagingKey, key)
 			continue
 		}
 
-		production = append(production, mapBlockMsg[key])
+		productionKey = append(productionKey, key)
+	}
+
+	if getStatus == StatusOpen {
+		staging = GroupRCABlockByStage(lang, stagingKey, mapStage, mapBlockMsg)
+		production = GroupRCABlockByStage(lang, productionKey, mapStage, mapBlockMsg)
+	} else {
+		for _, key := range stagingKey {
+			staging = append(staging, mapBlockMsg[key])
+		}
+
+		for _, key := range productionKey {
+			production = append(production, mapBlockMsg[key])
+		}
 	}
 
 	slackMsg := SlackMsgStructure{}
@@ -836,7 +999,7 @@ func ConstructRCADataString(v Channel, getStatus int, uname string) SlackMsgStru
 		anyRCA = true
 		//block := GetSlackMessageStructure(":arrow_right: `Environment: Staging`\n\n" + staging)
 		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackDividerBlock())
-		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(":arrow_right: `Environment: Staging`\n\n"))
+		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(T(lang, "list.env_staging")))
 		slackMsg.Blocks = append(slackMsg.Blocks, staging...)
 
 	}
@@ -851,19 +1014,23 @@ func ConstructRCADataString(v Channel, getStatus int, uname string) SlackMsgStru
 		//block := GetSlackMessageStructure(spacing + ":arrow_right: `Environment: Production`\n\n" + production)
 
 		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackDividerBlock())
-		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(spacing+":arrow_right: `Environment: Production`\n\n"))
+		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(spacing+T(lang, "list.env_production")))
 		slackMsg.Blocks = append(slackMsg.Blocks, production...)
 
 	}
 
-	if !anyRCA || getStatus == 1 {
+	if nav != nil {
+		slackMsg.Blocks = append(slackMsg.Blocks, *nav)
+	}
+
+	if !anyRCA || getStatus != StatusOpen {
 
 		foot := ""
-		if !anyRCA && getStatus == 0 {
-			foot = "\n\n*No RCA Item - Great Job Team* ! :muscle: :muscle: :muscle:"
+		if !anyRCA && getStatus == StatusOpen {
+			foot = T(lang, "list.empty")
 		}
 
-		quote := "_`True stability results when presumed order and presumed disorder are balanced. A truly stable system expects the unexpected, is prepared to be disrupted, waits to be transformed`_ - Tom Robbins\n"
+		quote := T(lang, "list.quote")
 
 		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackDividerBlock())
 		block := GetSlackMessageStructure(quote)
@@ -878,7 +1045,7 @@ func ConstructRCADataString(v Channel, getStatus int, uname string) SlackMsgStru
 
 	} else {
 
-		foot := "\n`Lets maintain our stability together with #gotongroyong and #makeithappenmakeitbetter spirit` :muscle: \n\n_*Please prepare the Deck*_ ya Team!"
+		foot := T(lang, "list.footer")
 
 		if v.Footer != "" {
 			foot = v.Footer
@@ -889,23 +1056,126 @@ func ConstructRCADataString(v Channel, getStatus int, uname string) SlackMsgStru
 		slackMsg.Blocks = append(slackMsg.Blocks, blockFoot)
 	}
 
-	slackMsg = AppendFootNotes(slackMsg)
+	slackMsg = AppendFootNotes(lang, slackMsg)
 
 	return slackMsg
 }
 
-func GetSlackAccessory(text, value string) *BlockAcc {
+func GetRCAItemText(lang Language, emot, issueID string, is RCAData) string {
+	pma := ""
+	if is.PMA != "" {
+		pma = fmt.Sprintf("- <%s|*PMA*> ", is.PMA)
+	}
+
+	issueID = is.DisplayID(issueID)
+
+	sev := ""
+	if is.Severity != 0 {
+		sev = fmt.Sprintf("%s `%s` ", SeverityEmoji(is.Severity), SeverityLabel(is.Severity))
+	}
+
+	text := T(lang, "item.header", emot, sev, is.Title, pma, issueID, is.Description, is.Assignee)
+
+	if IsOverdue(is, time.Now()) {
+		text += T(lang, "item.overdue", is.DueDate)
+	} else if is.DueDate != "" {
+		text += T(lang, "item.due", is.DueDate)
+	}
+
+	if IsSnoozed(is, time.Now()) {
+		text += T(lang, "item.snoozed", is.SnoozedUntil)
+	}
+
+	if len(is.Actions) > 0 {
+		text += T(lang, "item.actions", is.OpenActionCount(), len(is.Actions))
+	}
+
+	if len(is.Tags) > 0 {
+		text += T(lang, "item.tags", FormatTags(is.Tags))
+	}
+
+	impact := []string{}
+	if is.AffectedUsers != "" {
+		impact = append(impact, T(lang, "item.affected", is.AffectedUsers))
+	}
+	if is.Duration != "" {
+		impact = append(impact, T(lang, "item.duration", is.Duration))
+	}
+	if is.BusinessImpact != "" {
+		impact = append(impact, T(lang, "item.business", is.BusinessImpact))
+	}
+
+	if len(impact) > 0 {
+		text += T(lang, "item.impact", strings.Join(impact, " | "))
+	}
+
+	return text + "\n"
+}
+
+func GroupRCABlockByStage(lang Language, keys []string, mapStage map[string]RCAStatus, mapBlockMsg map[string]BlockStructure) []BlockStructure {
+	blocks := []BlockStructure{}
+
+	for _, stage := range ActiveStatuses {
+		stageBlocks := []BlockStructure{}
+		for _, key := range keys {
+			if mapStage[key] == stage {
+				stageBlocks = append(stageBlocks, mapBlockMsg[key])
+			}
+		}
+
+		if len(stageBlocks) == 0 {
+			continue
+		}
+
+		blocks = append(blocks, GetSlackMessageStructure(T(lang, "list.stage", stage, len(stageBlocks))))
+		blocks = append(blocks, stageBlocks...)
+	}
+
+	return blocks
+}
+
+// GetSlackAccessory is the button of an interactive action, the label comes
+// from the catalog and the action_id picks the handler.
+func GetSlackAccessory(lang Language, actionID, value string) *BlockAcc {
 	return &BlockAcc{
-		Type:  "button",
-		Value: value,
-		Text: BlockAccText{
+		Type:     "button",
+		ActionID: actionID,
+		Value:    value,
+		Text: &BlockAccText{
 			Type:  "plain_text",
-			Text:  text,
+			Text:  T(lang, "button."+actionID),
 			Emoji: true,
 		},
 	}
 }
 
+// GetRCAItemMenu is the select menu of one RCA in a list, every option value
+// holds the action_id and the issue ID. A static_select takes up to 100
+// options where an overflow stops at five, Edit needs the bot token and is
+// left to the Show details buttons.
+func GetRCAItemMenu(lang Language, issueID string, is RCAData) *BlockAcc {
+	var actions []string
+
+	switch {
+	case is.Status == StatusRemoved:
+		actions = []string{ActionRestore, ActionShow}
+	case is.Status.IsActive():
+		actions = []string{ActionSetDone, ActionReassign, ActionSetPMA, ActionSnooze, ActionRemove, ActionShow}
+	default:
+		actions = []string{ActionReassign, ActionSetPMA, ActionRemove, ActionShow}
+	}
+
+	menu := &BlockAcc{Type: "static_select", ActionID: ActionMenu, Placeholder: plainTextRef(T(lang, "menu.placeholder"))}
+	for _, actionID := range actions {
+		menu.Options = append(menu.Options, ViewOption{
+			Text:  plainText(T(lang, "button."+actionID)),
+			Value: OverflowValue(actionID, issueID),
+		})
+	}
+
+	return menu
+}
+
 func GetSlackMessageStructure(msg string, acc ...*BlockAcc) BlockStructure {
 	b := BlockStructure{
 		Type: "section",
@@ -922,31 +1192,77 @@ func GetSlackMessageStructure(msg string, acc ...*BlockAcc) BlockStructure {
 	return b
 }
 
+// GetSlackTextBlocks spreads lines over as many sections as it takes to keep
+// each one within MaxSlackSectionText, a single longer line is cut.
+func GetSlackTextBlocks(lines []string) []BlockStructure {
+	blocks := []BlockStructure{}
+	chunk := ""
+
+	for _, line := range lines {
+		if runes := []rune(line); len(runes) > MaxSlackSectionText {
+			line = string(runes[:MaxSlackSectionText-1]) + "…"
+		}
+
+		if chunk != "" && len([]rune(chunk))+1+len([]rune(line)) > MaxSlackSectionText {
+			blocks = append(blocks, GetSlackMessageStructure(chunk))
+			chunk = ""
+		}
+
+		if chunk != "" {
+			chunk += "\n"
+		}
+		chunk += line
+	}
+
+	if chunk != "" {
+		blocks = append(blocks, GetSlackMessageStructure(chunk))
+	}
+
+	return blocks
+}
+
+// GetPageNavBlock holds the Previous/Next buttons of a paged list, their value
+// is the command and its arguments for the target page.
+func GetPageNavBlock(lang Language, command string, page, pages int, listArgs string) BlockStructure {
+	buttons := []*BlockAcc{}
+
+	if page > 1 {
+		buttons = append(buttons, GetSlackAccessory(lang, ActionPrevious, PageValue(command, fmt.Sprintf("%s page=%d", listArgs, page-1))))
+	}
+
+	if page < pages {
+		buttons = append(buttons, GetSlackAccessory(lang, ActionNext, PageValue(command, fmt.Sprintf("%s page=%d", listArgs, page+1))))
+	}
+
+	return GetSlackActionsBlock(buttons...)
+}
+
+// GetSlackActionsBlock lays the buttons out in one row.
+func GetSlackActionsBlock(elements ...*BlockAcc) BlockStructure {
+	return BlockStructure{
+		Type:     "actions",
+		Elements: elements,
+	}
+}
+
 func GetSlackDividerBlock() BlockStructure {
 	return BlockStructure{
 		Type: "divider",
 	}
 }
 
-func AppendFootNotes(slackMsg SlackMsgStructure) SlackMsgStructure {
+func AppendFootNotes(lang Language, slackMsg SlackMsgStructure) SlackMsgStructure {
 	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackDividerBlock())
-	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure("\nType `/internalrcahelp` :dart: for more commands"))
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(T(lang, "list.footnote")))
 	return slackMsg
 }
 
 func GetAllRCAData() (map[string]Channel, error) {
-	var channels map[string]Channel
-
-	ctx := context.Background()
-	err := FirebaseClient.NewRef("Channel").Get(ctx, &channels)
-	return channels, err
+	return Store.GetAllChannels()
 }
 
 func GetAllSchedulerData() (map[string]string, error) {
-	var scheduler map[string]string
-	ctx := context.Background()
-	err := FirebaseClient.NewRef("Scheduler").Get(ctx, &scheduler)
-	return scheduler, err
+	return Store.GetAllSchedules()
 }
 
 //global
@@ -957,8 +1273,8 @@ func startProcessCron() {
 		Println(nil, err)
 	}
 
-	for _, v := range channels {
-		slackMsg := ConstructRCADataString(v, 0, "")
-		NotifySlack(slackMsg, v.ChannelKey)
+	for channelID, v := range channels {
+		slackMsg := ConstructRCADataString(v, StatusOpen, "", 1, "")
+		NotifySlack(slackMsg, channelID, v.ChannelKey)
 	}
 }
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ArgToken is one argument of a slash command. Key is set for key=value
+// flags, Col is the 1-based column the token starts at, used in errors.
+type ArgToken struct {
+	Key   string
+	Value string
+	Col   int
+}
+
+// TokenizeArgs splits command text into arguments. A token that starts with
+// "double" or 'single' quotes or a (parenthesized) group from the legacy
+// syntax is read up to the closing one, groups may nest and end the token,
+// so (title)(desc) are two. Anywhere else quotes and parentheses are plain
+// text, like in URLs, and a backslash only escapes a quote or parenthesis.
+// key=value flags may quote or parenthesize their value. An apostrophe
+// without a closing one is kept as is, so can't needs no escaping.
+func TokenizeArgs(text string) ([]ArgToken, error) {
+	runes := []rune(text)
+	tokens := []ArgToken{}
+
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		token := ArgToken{Col: i + 1}
+
+		// key=value flag, the key must be a plain identifier
+		if end := scanFlagKey(runes, i); end > i {
+			token.Key = strings.ToLower(string(runes[i:end]))
+			i = end + 1
+		}
+
+		value, next, err := scanArgValue(runes, i)
+		if err != nil {
+			return nil, err
+		}
+
+		token.Value = value
+		tokens = append(tokens, token)
+		i = next
+	}
+
+	return tokens, nil
+}
+
+// scanFlagKey returns the index of '=' when runes[start:] begins with
+// identifier=, otherwise start.
+func scanFlagKey(runes []rune, start int) int {
+	for i := start; i < len(runes); i++ {
+		r := runes[i]
+		if r == '=' && i > start {
+			return i
+		}
+
+		if !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-') {
+			return start
+		}
+	}
+
+	return start
+}
+
+func isArgQuote(r rune) bool {
+	return r == '"' || r == '\'' || r == '(' || r == ')'
+}
+
+func scanArgValue(runes []rune, start int) (string, int, error) {
+	var b strings.Builder
+	i := start
+
+	if i < len(runes) {
+		switch r := runes[i]; {
+		case r == '(':
+			end, err := scanParen(runes, i, &b)
+			return b.String(), end, err
+
+		case r == ')':
+			return "", 0, MsgError("args.unexpected_paren", i+1, argContext(runes, i))
+
+		case r == '"' || (r == '\'' && closingQuote(runes, i) >= 0):
+			end, err := scanQuoted(runes, i, &b)
+			if err != nil {
+				return "", 0, err
+			}
+			i = end
+		}
+	}
+
+	for i < len(runes) && !unicode.IsSpace(runes[i]) {
+		r := runes[i]
+
+		if r == '\\' && i+1 < len(runes) && isArgQuote(runes[i+1]) {
+			b.WriteRune(runes[i+1])
+			i += 2
+			continue
+		}
+
+		b.WriteRune(r)
+		i++
+	}
+
+	return b.String(), i, nil
+}
+
+// closingQuote returns the index of the quote that closes runes[start], -1
+// when there is none. A single quote only closes at the end of a word, so
+// 'can't stop' stays one value.
+func closingQuote(runes []rune, start int) int {
+	quote := runes[start]
+
+	for i := start + 1; i < len(runes); i++ {
+		r := runes[i]
+
+		if r == '\\' && i+1 < len(runes) && runes[i+1] == quote {
+			i++
+			continue
+		}
+
+		if r != quote {
+			continue
+		}
+
+		if quote == '"' || i+1 == len(runes) || unicode.IsSpace(runes[i+1]) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// scanQuoted reads a quoted value, inside it a backslash only escapes the
+// quote itself.
+func scanQuoted(runes []rune, start int, b *strings.Builder) (int, error) {
+	quote := runes[start]
+	end := closingQuote(runes, start)
+
+	for i := start + 1; end >= 0 && i < len(runes); i++ {
+		r := runes[i]
+
+		if r == '\\' && i+1 < len(runes) && runes[i+1] == quote {
+			b.WriteRune(quote)
+			i++
+			continue
+		}
+
+		if i == end {
+			return i + 1, nil
+		}
+
+		b.WriteRune(r)
+	}
+
+	return 0, MsgError("args.unterminated_quote", quote, start+1, argContext(runes, start))
+}
+
+// scanParen reads a (group) that may nest, a backslash only escapes a
+// parenthesis.
+func scanParen(runes []rune, start int, b *strings.Builder) (int, error) {
+	depth := 0
+
+	for i := start; i < len(runes); i++ {
+		r := runes[i]
+
+		if r == '\\' && i+1 < len(runes) && (runes[i+1] == '(' || runes[i+1] == ')') {
+			b.WriteRune(runes[i+1])
+			i++
+			continue
+		}
+
+		if r == '(' {
+			depth++
+			if depth == 1 {
+				continue
+			}
+		}
+
+		if r == ')' {
+			depth--
+			if depth == 0 {
+				return i + 1, nil
+			}
+		}
+
+		b.WriteRune(r)
+	}
+
+	return 0, MsgError("args.missing_paren", start+1, argContext(runes, start))
+}
+
+func argContext(runes []rune, at int) string {
+	end := at + 20
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	return string(runes[at:end])
+}
+
+// PositionalArgs returns the values of tokens that are not flags.
+func PositionalArgs(tokens []ArgToken) []string {
+	values := []string{}
+	for _, t := range tokens {
+		if t.Key == "" {
+			values = append(values, t.Value)
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenizeArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    []string
+		wantErr bool
+	}{
+		{name: "legacy groups", text: "(DB down) (primary lost) @budi", want: []string{"DB down", "primary lost", "@budi"}},
+		{name: "adjacent groups", text: "(title)(desc) budi", want: []string{"title", "desc", "budi"}},
+		{name: "nested group", text: "(a (b) c)", want: []string{"a (b) c"}},
+		{name: "quotes", text: `"a b" 'c d'`, want: []string{"a b", "c d"}},
+		{name: "url with parentheses", text: "https://x.com/?q=1&x=(2)", want: []string{"https://x.com/?q=1&x=(2)"}},
+		{name: "wiki link", text: "https://en.wikipedia.org/wiki/Foo_(bar)", want: []string{"https://en.wikipedia.org/wiki/Foo_(bar)"}},
+		{name: "backslash kept", text: `C:\tmp \\server\share`, want: []string{`C:\tmp`, `\\server\share`}},
+		{name: "escaped paren", text: `\(not a group\)`, want: []string{"(not", "a", "group)"}},
+		{name: "escaped quote in quotes", text: `"say \"hi\""`, want: []string{`say "hi"`}},
+		{name: "apostrophes", text: "can't 'tis it's", want: []string{"can't", "'tis", "it's"}},
+		{name: "single quoted apostrophe", text: "'can't stop'", want: []string{"can't stop"}},
+		{name: "flag with group", text: "title=(DB down) pma=https://x.com/a_(b)", want: []string{"DB down", "https://x.com/a_(b)"}},
+		{name: "unterminated quote", text: `"open`, wantErr: true},
+		{name: "missing paren", text: "(open", wantErr: true},
+		{name: "stray paren", text: ") x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := TokenizeArgs(tt.text)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("TokenizeArgs(%q) = %+v, want an error", tt.text, tokens)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TokenizeArgs(%q) error: %v", tt.text, err)
+			}
+
+			got := []string{}
+			for _, tok := range tokens {
+				got = append(got, tok.Value)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TokenizeArgs(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAddRCAArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    RCAData
+		wantErr bool
+	}{
+		{
+			name: "legacy spaced",
+			text: "(DB down) (primary lost) @budi https://pma.example/1 staging",
+			want: RCAData{Title: "DB down", Description: "primary lost", Assignee: "@budi", PMA: "https://pma.example/1", Environment: "Staging"},
+		},
+		{
+			name: "legacy adjacent groups",
+			text: "(DB down)(primary lost) budi",
+			want: RCAData{Title: "DB down", Description: "primary lost", Assignee: "budi", Environment: "Production"},
+		},
+		{
+			name: "pma with parentheses and backslash",
+			text: `(t) (d) @budi https://wiki.example/Foo_(bar)?q=a\b`,
+			want: RCAData{Title: "t", Description: "d", Assignee: "@budi", PMA: `https://wiki.example/Foo_(bar)?q=a\b`, Environment: "Production"},
+		},
+		{
+			name: "flags",
+			text: `title="Cache miss" desc='can't warm up' assignee=@andi`,
+			want: RCAData{Title: "Cache miss", Description: "can't warm up", Assignee: "@andi", Environment: "Production"},
+		},
+		{
+			name:    "missing assignee",
+			text:    "(DB down) (primary lost)",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAddRCAArgs(tt.text)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseAddRCAArgs(%q) = %+v, want an error", tt.text, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAddRCAArgs(%q) error: %v", tt.text, err)
+			}
+
+			tt.want.Status = StatusOpen
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseAddRCAArgs(%q)\n got %+v\nwant %+v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
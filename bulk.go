@@ -0,0 +1,296 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	MaxBulkResultLines = 40  //the rest is summed up in one line
+	MaxBulkDetailLen   = 200 //per item, a long description edit is cut
+)
+
+// BulkTargets are the items a command works on, given as IDs, as a selector
+// filter (assignee=@x env=staging sev>=2 ...) or both.
+type BulkTargets struct {
+	IDs    []string
+	Filter RCAFilter
+}
+
+// BulkResult is the outcome for one target, Data is the item before the
+// change. Label is what the user typed for IDs that could not be resolved.
+type BulkResult struct {
+	IssueID string
+	Label   string
+	Data    RCAData
+	Err     error
+}
+
+// ParseBulkTargets treats bare tokens as issue IDs, except severity filters
+// like sev>=2, and every flag as a selector.
+func ParseBulkTargets(tokens []ArgToken) (BulkTargets, error) {
+	t := BulkTargets{Filter: RCAFilter{Now: time.Now()}}
+
+	for _, tok := range tokens {
+		if tok.Key == "" && !severityFilterRegex.MatchString(strings.ToLower(tok.Value)) {
+			t.IDs = append(t.IDs, tok.Value)
+			continue
+		}
+
+		if err := t.Filter.set(tok); err != nil {
+			return t, err
+		}
+	}
+
+	return t, nil
+}
+
+func (t BulkTargets) IsEmpty() bool {
+	return len(t.IDs) == 0 && t.Filter.IsEmpty()
+}
+
+// IsSingle is the classic one issueID form.
+func (t BulkTargets) IsSingle() bool {
+	return len(t.IDs) == 1 && t.Filter.IsEmpty()
+}
+
+func (t BulkTargets) String() string {
+	return strings.TrimSpace(strings.Join(t.IDs, " ") + " " + t.Filter.String())
+}
+
+// ResolveBulkTargets maps the targets to storage keys in the order given,
+// selector matches follow in creation order. selectable limits which items
+// a selector may pick, explicit IDs are always taken.
+func ResolveBulkTargets(ch Channel, t BulkTargets, selectable func(RCAData) bool) []BulkResult {
+	results := []BulkResult{}
+	seen := map[string]bool{}
+
+	add := func(issueID string) {
+		if !seen[issueID] {
+			seen[issueID] = true
+			results = append(results, BulkResult{IssueID: issueID, Data: ch.Data[issueID]})
+		}
+	}
+
+	for _, id := range t.IDs {
+		issueID := NormalizeIssueID(id)
+		if _, ok := ch.Data[issueID]; !ok {
+			if target, ok := ch.Alias[issueID]; ok {
+				issueID = target
+			}
+		}
+
+		if ch.Data[issueID].Title == "" {
+			results = append(results, BulkResult{Label: id, Err: MsgError("bulk.invalid_id", id)})
+			continue
+		}
+
+		add(issueID)
+	}
+
+	if !t.Filter.IsEmpty() {
+		keys := []string{}
+		for issueID := range ch.Data {
+			keys = append(keys, issueID)
+		}
+		SortIssueKeysByCreated(keys, ch.Data)
+
+		for _, issueID := range keys {
+			is := ch.Data[issueID]
+			if selectable(is) && t.Filter.Match(issueID, is) {
+				add(issueID)
+			}
+		}
+	}
+
+	return results
+}
+
+// ApplyBulkRCA runs fn on a copy of every target first and only writes when
+// all of them pass, then applies it again in one store update. It reports
+// whether the change was written.
+func ApplyBulkRCA(channelID string, results []BulkResult, fn func(issueID string, data *RCAData) error) ([]BulkResult, bool, error) {
+	failed := false
+	keys := []string{}
+
+	for i, r := range results {
+		if r.Err != nil {
+			failed = true
+			continue
+		}
+
+		var v RCAData
+		if err := copyStoreData(&v, r.Data); err != nil {
+			return results, false, err
+		}
+
+		if err := fn(r.IssueID, &v); err != nil {
+			results[i].Err = err
+			failed = true
+			continue
+		}
+
+		keys = append(keys, r.IssueID)
+	}
+
+	if failed {
+		return results, false, nil
+	}
+
+	err := Store.UpdateRCAs(channelID, keys, func(issueID string, data *RCAData) error {
+		if data.Title == "" {
+			return ErrRCANotFound
+		}
+
+		return fn(issueID, data)
+	})
+
+	return results, err == nil, err
+}
+
+// FormatBulkResults lists every target, detail adds text after a successful
+// item. When nothing was applied the items that passed are marked as skipped.
+func FormatBulkResults(lang Language, results []BulkResult, applied bool, detail func(r BulkResult) string) string {
+	lines := []string{}
+
+	for i, r := range results {
+		if i == MaxBulkResultLines {
+			lines = append(lines, T(lang, "bulk.more", len(results)-i))
+			break
+		}
+
+		if r.Err != nil {
+			label := r.Label
+			if label == "" {
+				label = r.Data.DisplayID(r.IssueID)
+			}
+
+			lines = append(lines, fmt.Sprintf("• :x: `%s` - %s", label, strings.TrimPrefix(LocalizeError(lang, r.Err), T(lang, "failed.prefix"))))
+			continue
+		}
+
+		if !applied {
+			lines = append(lines, T(lang, "bulk.skipped", r.Data.Title, r.Data.DisplayID(r.IssueID)))
+			continue
+		}
+
+		line := fmt.Sprintf("• :white_check_mark: %s (`%s`)", r.Data.Title, r.Data.DisplayID(r.IssueID))
+		if detail != nil {
+			if d := []rune(detail(r)); len(d) > MaxBulkDetailLen {
+				line += string(d[:MaxBulkDetailLen-1]) + "…"
+			} else {
+				line += string(d)
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func bulkFailedError(lang Language, action LocalizedText, uname string, results []BulkResult) error {
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+		}
+	}
+
+	return errors.New(T(lang, "bulk.failed", failed, len(results), action, uname) + "\n" + FormatBulkResults(lang, results, false, nil))
+}
+
+// BulkSetRCAStatus sets Done or Removed on every target or on none of them.
+func BulkSetRCAStatus(uname, channelID string, targets BulkTargets, status RCAStatus, force bool) (string, error) {
+	lang := ChannelLanguage(channelID)
+	setTo := Msg("action.set_done")
+	command := "/donerca"
+	if status == StatusRemoved {
+		setTo = Msg("action.remove")
+		command = "/removerca"
+	}
+
+	ch, err := Store.GetChannel(channelID)
+	if err != nil {
+		return "", err
+	}
+
+	results := ResolveBulkTargets(ch, targets, func(is RCAData) bool {
+		return is.Status.IsActive()
+	})
+
+	if len(results) == 0 {
+		return "", MsgError("bulk.no_active_match", targets)
+	}
+
+	results, applied, err := ApplyBulkRCA(channelID, results, func(issueID string, data *RCAData) error {
+		return ApplyRCAStatus(issueID, data, status, force)
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if !applied {
+		return "", bulkFailedError(lang, setTo, uname, results)
+	}
+
+	for _, r := range results {
+		RecordRCAEvent(channelID, r.IssueID, uname, command, "Status", r.Data.Status.String(), status.String())
+	}
+
+	return T(lang, "bulk.status_done", len(results), setTo, uname) + "\n" + FormatBulkResults(lang, results, true, nil), nil
+}
+
+// BulkEditRCA applies the same edits to every target or to none of them.
+func BulkEditRCA(uname, channelID string, targets BulkTargets, edits []RCAFieldEdit) (string, error) {
+	lang := ChannelLanguage(channelID)
+	ch, err := Store.GetChannel(channelID)
+	if err != nil {
+		return "", err
+	}
+
+	results := ResolveBulkTargets(ch, targets, func(is RCAData) bool {
+		return is.Status != StatusRemoved
+	})
+
+	if len(results) == 0 {
+		return "", MsgError("bulk.no_match", targets)
+	}
+
+	changes := map[string][]RCAFieldChange{}
+	results, applied, err := ApplyBulkRCA(channelID, results, func(issueID string, data *RCAData) error {
+		c, err := ApplyRCAFieldEdits(data, edits)
+		changes[issueID] = c
+		return err
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if !applied {
+		return "", bulkFailedError(lang, Msg("action.edited"), uname, results)
+	}
+
+	for _, r := range results {
+		for _, c := range changes[r.IssueID] {
+			RecordRCAEvent(channelID, r.IssueID, uname, "/editrca", c.Field.Label, c.OldValue, c.NewValue)
+		}
+	}
+
+	detail := func(r BulkResult) string {
+		if len(changes[r.IssueID]) == 0 {
+			return T(lang, "bulk.unchanged")
+		}
+
+		parts := []string{}
+		for _, c := range changes[r.IssueID] {
+			parts = append(parts, fmt.Sprintf("%s: %s → %s", c.Field.Name, changeValue(lang, c.OldValue), changeValue(lang, c.NewValue)))
+		}
+		return " - " + strings.Join(parts, ", ")
+	}
+
+	return T(lang, "bulk.edit_done", len(results), uname) + "\n" + FormatBulkResults(lang, results, true, detail), nil
+}
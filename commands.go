@@ -0,0 +1,739 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+type CommandPermission int
+
+const (
+	PermissionEveryone CommandPermission = iota
+	PermissionAdmin
+)
+
+// Interactive action_ids, button labels are looked up as "button.<id>".
+const (
+	ActionSetDone  = "set_done"
+	ActionRemove   = "remove"
+	ActionRestore  = "restore"
+	ActionSetPMA   = "set_pma"
+	ActionEdit     = "edit"
+	ActionReassign = "reassign"
+	ActionSnooze   = "snooze"
+	ActionShow     = "show"
+	ActionPrevious = "previous"
+	ActionNext     = "next"
+
+	ActionMenu = "rca_menu" //the select menu itself, the picked option names the action
+)
+
+type ReplyVisibility int
+
+const (
+	ReplyInChannel ReplyVisibility = iota
+	ReplyEphemeral                 //only the caller sees it, through the response_url
+)
+
+// CommandContext is one slash command or button click. For buttons Text
+// holds the button value and MessageTS the message the button is on.
+type CommandContext struct {
+	UserName    string
+	UserID      string
+	ChannelID   string
+	Command     string
+	Text        string
+	MessageTS   string
+	ResponseURL string
+	TriggerID   string //opens modals, valid for 3 seconds
+	ChannelData Channel
+	Lang        Language
+}
+
+// CommandResult is what a handler sends back. Blocks and Message go out as
+// the command's Reply says, Ephemeral is only shown to the caller. Replace
+// updates the message the button was clicked on.
+type CommandResult struct {
+	Blocks       SlackMsgStructure
+	Message      string
+	Ephemeral    string
+	Replace      bool
+	ChannelKey   string //overrides the channel webhook
+	ChannelID    string
+	MessageTS    string
+	ResponseURL  string
+	ResponseType string
+}
+
+type Command struct {
+	Name        string
+	ActionID    string //interactive actions only, the button or menu option key
+	Aliases     []string
+	Args        string
+	MinArgs     int
+	Help        string
+	NeedChannel bool //loads the channel, which must have a webhook or be reachable by the bot token
+	Reply       ReplyVisibility
+	Undoable    bool //changes RCA items, /undorca can revert it
+	Permission  CommandPermission
+	Handler     func(ctx CommandContext) (CommandResult, error)
+}
+
+var (
+	commandRegistry = []*Command{}
+	commandIndex    = map[string]*Command{}
+
+	actionIndex      = map[string]*Command{}
+	actionLabelIndex = map[string]*Command{} //buttons posted before they had an action_id
+)
+
+// RegisterCommand adds a slash command, the registration order is the order
+// shown in /internalrcahelp.
+func RegisterCommand(cmd Command) {
+	c := &cmd
+	commandRegistry = append(commandRegistry, c)
+
+	for _, name := range append([]string{cmd.Name}, cmd.Aliases...) {
+		if _, ok := commandIndex[name]; ok {
+			panic("duplicate command " + name)
+		}
+		commandIndex[name] = c
+	}
+}
+
+// RegisterAction adds an interactive action handler keyed by its action_id,
+// Name is the English label older messages dispatched on.
+func RegisterAction(cmd Command) {
+	if _, ok := actionIndex[cmd.ActionID]; ok {
+		panic("duplicate action " + cmd.ActionID)
+	}
+	actionIndex[cmd.ActionID] = &cmd
+	actionLabelIndex[cmd.Name] = &cmd
+}
+
+func FindCommand(name string) (*Command, bool) {
+	cmd, ok := commandIndex[strings.ToLower(name)]
+	return cmd, ok
+}
+
+func FindAction(actionID, label string) (*Command, bool) {
+	if cmd, ok := actionIndex[actionID]; ok {
+		return cmd, true
+	}
+
+	cmd, ok := actionLabelIndex[label]
+	return cmd, ok
+}
+
+// OverflowValue is the value of a menu option, Slack only reports the menu's
+// own action_id so the option carries the action.
+func OverflowValue(actionID, value string) string {
+	return actionID + " " + value
+}
+
+func ParseOverflowValue(value string) (string, string) {
+	parts := strings.SplitN(value, " ", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
+
+func (cmd Command) Usage() string {
+	if cmd.Args == "" {
+		return cmd.Name
+	}
+
+	return cmd.Name + " " + cmd.Args
+}
+
+// HelpText is the catalog translation of Help when there is one.
+func (cmd Command) HelpText(lang Language) string {
+	if text, ok := messageCatalog["help."+cmd.Name][lang]; ok {
+		return text
+	}
+
+	return cmd.Help
+}
+
+func (cmd Command) HelpLine(lang Language) string {
+	line := fmt.Sprintf("• `%s` - %s", cmd.Usage(), cmd.HelpText(lang))
+
+	if len(cmd.Aliases) > 0 {
+		line += T(lang, "help.alias", strings.Join(cmd.Aliases, "`, `"))
+	}
+
+	if cmd.Permission == PermissionAdmin {
+		line += " :lock:"
+	}
+
+	return line
+}
+
+// IsRCAAdmin checks the caller against RCA_ADMINS, a comma separated list of
+// Slack user names or IDs. When it is empty everyone is an admin.
+func IsRCAAdmin(uname, userID string) bool {
+	admins := strings.TrimSpace(os.Getenv("RCA_ADMINS"))
+	if admins == "" {
+		return true
+	}
+
+	for _, admin := range strings.Split(admins, ",") {
+		admin = strings.TrimPrefix(strings.TrimSpace(admin), "@")
+		if admin != "" && (admin == uname || admin == userID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ExecuteCommand checks permission, arguments and the channel webhook before
+// handing the context to the command handler, and keeps the undo snapshot of
+// commands that change RCA items. Errors come back in the channel language.
+func ExecuteCommand(cmd *Command, ctx CommandContext) (CommandResult, error) {
+	ctx.Lang = ChannelLanguage(ctx.ChannelID)
+
+	result, err := executeCommand(cmd, ctx)
+	if err != nil {
+		return result, errors.New(LocalizeError(ctx.Lang, err))
+	}
+
+	return result, nil
+}
+
+func executeCommand(cmd *Command, ctx CommandContext) (CommandResult, error) {
+	result := CommandResult{}
+
+	if cmd.Permission == PermissionAdmin && !IsRCAAdmin(ctx.UserName, ctx.UserID) {
+		return result, MsgError("command.admin_only", cmd.Name, ctx.UserName)
+	}
+
+	if len(strings.Fields(ctx.Text)) < cmd.MinArgs {
+		return result, MsgError("command.usage", cmd.Usage())
+	}
+
+	if cmd.NeedChannel {
+		channelData, err := GetRCAData(ctx.ChannelID)
+		if err != nil {
+			return result, err
+		}
+
+		if !CanPostTo(channelData) && ctx.ResponseURL == "" {
+			return result, MsgError("webhook.not_set")
+		}
+
+		ctx.ChannelData = channelData
+		result.ChannelKey = channelData.ChannelKey
+	}
+
+	before := ctx.ChannelData
+	if cmd.Undoable && !cmd.NeedChannel {
+		channelData, err := GetRCAData(ctx.ChannelID)
+		if err != nil {
+			return result, err
+		}
+		before = channelData
+	}
+
+	if cmd.Undoable {
+		TrackUndo(ctx.UserName, ctx.ChannelID)
+	}
+
+	res, err := cmd.Handler(ctx)
+	touched := UntrackUndo(ctx.UserName, ctx.ChannelID)
+	if err != nil {
+		return result, err
+	}
+
+	//opening a form touches nothing, the submission records its own snapshot
+	if len(touched) > 0 {
+		RecordUndoSnapshot(ctx.UserName, ctx.ChannelID, cmd.Name, before, touched)
+	}
+
+	if res.ChannelKey == "" {
+		res.ChannelKey = result.ChannelKey
+	}
+	res.ChannelID = ctx.ChannelID
+	res.MessageTS = ctx.MessageTS
+	res.ResponseURL = ctx.ResponseURL
+
+	res.ResponseType = ResponseInChannel
+	if cmd.Reply == ReplyEphemeral {
+		res.ResponseType = ResponseEphemeral
+	}
+
+	return res, nil
+}
+
+// pageResult renders the page a Previous/Next button points at, in place of
+// the message the button is on.
+func pageResult(ctx CommandContext) (CommandResult, error) {
+	var (
+		slackMsg SlackMsgStructure
+		err      error
+	)
+
+	command, args := ParsePageValue(ctx.Text)
+	switch command {
+	case "/searchrca":
+		slackMsg, err = SearchRCA(ctx.UserName, args, ctx.ChannelData)
+	case "/rcahistory":
+		slackMsg, err = RCAHistory(ctx.UserName, ctx.ChannelID, args)
+	case "/listrca":
+		slackMsg, err = ListRCA(ctx.UserName, args, ctx.ChannelData, StatusOpen)
+	case "/listremovedrca":
+		slackMsg, err = ListRCA(ctx.UserName, args, ctx.ChannelData, StatusRemoved)
+	default:
+		slackMsg, err = ListRCA(ctx.UserName, args, ctx.ChannelData, StatusDone)
+	}
+
+	return CommandResult{Blocks: slackMsg, Replace: true}, err
+}
+
+// PageValue is the value of a Previous/Next button, the command that renders
+// the list followed by its arguments for the target page.
+func PageValue(command, args string) string {
+	return strings.TrimSpace(command + " " + args)
+}
+
+// ParsePageValue splits a Previous/Next value, buttons from before the value
+// named a command only page the done list.
+func ParsePageValue(value string) (string, string) {
+	if !strings.HasPrefix(value, "/") {
+		return "/listdonerca", value
+	}
+
+	parts := strings.SplitN(value, " ", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
+
+func messageResult(msg string, err error) (CommandResult, error) {
+	return CommandResult{Message: msg}, err
+}
+
+func blocksResult(slackMsg SlackMsgStructure, err error) (CommandResult, error) {
+	return CommandResult{Blocks: slackMsg}, err
+}
+
+func HelpRCA(lang Language) string {
+	lines := []string{T(lang, "help.title")}
+
+	for _, cmd := range commandRegistry {
+		lines = append(lines, cmd.HelpLine(lang))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func init() {
+	RegisterCommand(Command{
+		Name:        "/listrca",
+		Args:        "[sev>=2] [assignee=@x] [env=staging] [tag=db] [older-than=7d] [page=N]",
+		Help:        "Get List Active RCA :memo::memo:, optionally filtered (`sev>=2` shows SEV1 & SEV2, filters work on every list command), most severe first with Previous/Next buttons",
+		NeedChannel: true,
+		Reply:       ReplyEphemeral,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return blocksResult(ListRCA(ctx.UserName, ctx.Text, ctx.ChannelData, StatusOpen))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:  "/myrca",
+		Args:  "[filters]",
+		Help:  "Get your active RCA from every channel, only shown to you",
+		Reply: ReplyEphemeral,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return blocksResult(MyRCA(ctx.Lang, ctx.UserName, ctx.UserID, ctx.Text))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/listdonerca",
+		Args:        "[since=YYYY-MM-DD] [until=YYYY-MM-DD] [page=N]",
+		Help:        "Get list of Done RCA, newest first with Previous/Next buttons",
+		NeedChannel: true,
+		Reply:       ReplyEphemeral,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return blocksResult(ListRCA(ctx.UserName, ctx.Text, ctx.ChannelData, StatusDone))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/searchrca",
+		Args:        "query [page=N]",
+		MinArgs:     1,
+		Help:        "Search title, description, assignee and PMA of every RCA (active, done & removed), use quotes for a phrase",
+		NeedChannel: true,
+		Reply:       ReplyEphemeral,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return blocksResult(SearchRCA(ctx.UserName, ctx.Text, ctx.ChannelData))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/addrca",
+		Args:        "(Title) (Desc) Assignee [PMATicketURL] [Staging|Production] [SEV1-4] [(Affected Users)] [(Duration)] [(Business Impact)] [DueDate] [tag1,tag2]",
+		Help:        "Add New RCA, without arguments it opens a form. `use parentheses or \"quotes\"` for multi space text, `-` to skip an optional field. Flags work too: `title= desc= assignee= pma= env= sev= users= duration= impact= due= tags=`. *Sample*: title=\"DB outage\" assignee=@budi env=staging sev=2",
+		NeedChannel: true,
+		Undoable:    true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			if strings.TrimSpace(ctx.Text) == "" && slackAPI != nil {
+				return CommandResult{}, OpenAddRCAView(ctx)
+			}
+
+			return messageResult(AddRCA(ctx.UserName, ctx.Text, ctx.ChannelID))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/editrca",
+		Args:        "issueID... [selector... set] field=value ...",
+		MinArgs:     2,
+		Help:        "Edit RCA fields (`title= desc= assignee= pma= env= sev= users= duration= impact= due= tags=`), use quotes for multi space text. Several IDs or a selector edit many at once, all or nothing. *Sample*: /editrca RCA-42 title=\"DB outage\" env=staging, /editrca assignee=@budi env=staging set assignee=@andi",
+		NeedChannel: true,
+		Undoable:    true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(EditRCA(ctx.UserName, ctx.ChannelID, ctx.Text))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/removerca",
+		Args:        "issueID... [selector]",
+		MinArgs:     1,
+		Help:        "Remove RCA, several IDs or a selector (`assignee= env= tag= sev>=2 older-than=`) remove all matching active RCA or none",
+		NeedChannel: true,
+		Undoable:    true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(DoneRCA(ctx.UserName, ctx.Text, ctx.ChannelID, StatusRemoved))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/listremovedrca",
+		Args:        "[page=N]",
+		Help:        "Get list of Removed RCA, latest first with Previous/Next buttons",
+		NeedChannel: true,
+		Reply:       ReplyEphemeral,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return blocksResult(ListRCA(ctx.UserName, ctx.Text, ctx.ChannelData, StatusRemoved))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/restorerca",
+		Args:        "issueID",
+		MinArgs:     1,
+		Help:        "Restore a Removed RCA to its previous status",
+		NeedChannel: true,
+		Undoable:    true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(RestoreRCA(ctx.UserName, ctx.Text, ctx.ChannelID))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/setstatus",
+		Args:        "issueID stage [force]",
+		MinArgs:     2,
+		Help:        "Move RCA to a stage (Open, Investigating, Deck Drafting, Review, Presented, Done), Done needs `force` while action items are open",
+		NeedChannel: true,
+		Undoable:    true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(SetStatus(ctx.UserName, ctx.ChannelID, ctx.Text))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/donerca",
+		Args:        "issueID... [selector] [force]",
+		MinArgs:     1,
+		Help:        "Set RCA to Done, blocked while action items are open unless `force`. Several IDs or a selector work like `/removerca`",
+		NeedChannel: true,
+		Undoable:    true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(DoneRCA(ctx.UserName, ctx.Text, ctx.ChannelID, StatusDone))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/doneallrca",
+		Help:        "*Done all* active RCA :warning::warning:, `/undorca` reverts it",
+		NeedChannel: true,
+		Undoable:    true,
+		Permission:  PermissionAdmin,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(DoneAllRCA(ctx.UserName, ctx.ChannelID, ctx.ChannelData))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/setpma",
+		Args:        "issueID PMATicketURL",
+		MinArgs:     2,
+		Help:        "Set PMA Ticket for issue",
+		NeedChannel: true,
+		Undoable:    true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(SetPMA(ctx.UserName, ctx.ChannelID, ctx.Text))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/setdue",
+		Args:        "issueID YYYY-MM-DD",
+		MinArgs:     2,
+		Help:        "Set deck due date for issue, `none` to clear",
+		NeedChannel: true,
+		Undoable:    true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(SetDue(ctx.UserName, ctx.ChannelID, ctx.Text))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/snoozerca",
+		Args:        "issueID [days|YYYY-MM-DD|off]",
+		MinArgs:     1,
+		Help:        "Leave an active RCA out of the scheduled list and the overdue reminder, for a day by default",
+		NeedChannel: true,
+		Undoable:    true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(SnoozeRCA(ctx.UserName, ctx.ChannelID, ctx.Text))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/addaction",
+		Args:        "issueID (Text) Owner [DueDate]",
+		MinArgs:     3,
+		Help:        "Add follow-up action item to RCA",
+		NeedChannel: true,
+		Undoable:    true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(AddAction(ctx.UserName, ctx.ChannelID, ctx.Text))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/doneaction",
+		Args:        "issueID actionID",
+		MinArgs:     2,
+		Help:        "Set action item to Done",
+		NeedChannel: true,
+		Undoable:    true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(DoneAction(ctx.UserName, ctx.ChannelID, ctx.Text))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/showrca",
+		Args:        "issueID",
+		MinArgs:     1,
+		Help:        "Show every field, action item and the history of an RCA",
+		NeedChannel: true,
+		Reply:       ReplyEphemeral,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return blocksResult(ShowRCA(ctx.UserName, ctx.ChannelID, ctx.Text))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/undorca",
+		Help:        "Undo your last change to RCA items in this channel (bulk ones included), within a few minutes",
+		NeedChannel: true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(UndoRCA(ctx.UserName, ctx.ChannelID))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/rcahistory",
+		Args:        "issueID [page=N]",
+		MinArgs:     1,
+		Help:        "Show every change made to an RCA, latest page first",
+		NeedChannel: true,
+		Reply:       ReplyEphemeral,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return blocksResult(RCAHistory(ctx.UserName, ctx.ChannelID, ctx.Text))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/setscheduler",
+		Args:        "schedule",
+		MinArgs:     1,
+		Help:        "Set Scheduler for RCA List (*<https://pkg.go.dev/github.com/robfig/cron/v3|format>*)",
+		NeedChannel: true,
+		Permission:  PermissionAdmin,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(SetScheduler(ctx.UserName, ctx.ChannelID, ctx.Text))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:       "/setslackwebhook",
+		Args:       "webhook_key",
+		MinArgs:    1,
+		Help:       "Set slack webhook for scheduler (*for the webhook url*, contact: <@U75J4HEF9>)",
+		Permission: PermissionAdmin,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			msg, err := SetWebhook(ctx.UserName, ctx.ChannelID, ctx.Text)
+			return CommandResult{Message: msg, ChannelKey: ctx.Text}, err
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/removescheduler",
+		Help:        "Remove Scheduler for RCA List",
+		NeedChannel: true,
+		Permission:  PermissionAdmin,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(RemoveScheduler(ctx.UserName, ctx.ChannelID))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/setfooter",
+		Args:        "text",
+		MinArgs:     1,
+		Help:        "Set *Custom* footer notes that shown at the bottom of RCA List",
+		NeedChannel: true,
+		Permission:  PermissionAdmin,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(SetFooter(ctx.UserName, ctx.ChannelID, ctx.Text))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:        "/setlanguage",
+		Args:        "id|en",
+		MinArgs:     1,
+		Help:        "Set the language of bot messages in this channel (`id` Bahasa Indonesia, `en` English)",
+		NeedChannel: true,
+		Permission:  PermissionAdmin,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(SetLanguage(ctx.UserName, ctx.ChannelID, ctx.Text))
+		},
+	})
+
+	RegisterCommand(Command{
+		Name:    "/internalrcahelp",
+		Aliases: []string{"/rcahelp"},
+		Help:    "Command list for RCA & Sharing Bot",
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return CommandResult{Ephemeral: HelpRCA(ctx.Lang)}, nil
+		},
+	})
+
+	RegisterAction(Command{
+		Name:        "Set Done",
+		ActionID:    ActionSetDone,
+		NeedChannel: true,
+		Undoable:    true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(DoneRCA(ctx.UserName, ctx.Text, ctx.ChannelID, StatusDone))
+		},
+	})
+
+	RegisterAction(Command{
+		Name:        "Remove",
+		ActionID:    ActionRemove,
+		NeedChannel: true,
+		Undoable:    true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(DoneRCA(ctx.UserName, ctx.Text, ctx.ChannelID, StatusRemoved))
+		},
+	})
+
+	RegisterAction(Command{
+		Name:     "Set PMA",
+		ActionID: ActionSetPMA,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			if slackAPI == nil {
+				msg, err := SetPMAHint(ctx.UserName, ctx.ChannelID, ctx.Text)
+				return CommandResult{Ephemeral: msg}, err
+			}
+
+			return CommandResult{}, OpenSetPMAView(ctx)
+		},
+	})
+
+	RegisterAction(Command{
+		Name:        "Edit",
+		ActionID:    ActionEdit,
+		NeedChannel: true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return CommandResult{}, OpenEditRCAView(ctx)
+		},
+	})
+
+	RegisterAction(Command{
+		Name:        "Reassign",
+		ActionID:    ActionReassign,
+		NeedChannel: true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			if slackAPI == nil {
+				msg, err := ReassignHint(ctx.UserName, ctx.ChannelID, ctx.Text)
+				return CommandResult{Ephemeral: msg}, err
+			}
+
+			return CommandResult{}, OpenReassignRCAView(ctx)
+		},
+	})
+
+	RegisterAction(Command{
+		Name:        "Snooze",
+		ActionID:    ActionSnooze,
+		NeedChannel: true,
+		Undoable:    true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(SnoozeRCA(ctx.UserName, ctx.ChannelID, ctx.Text))
+		},
+	})
+
+	RegisterAction(Command{
+		Name:        "Show details",
+		ActionID:    ActionShow,
+		NeedChannel: true,
+		Reply:       ReplyEphemeral,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return blocksResult(ShowRCA(ctx.UserName, ctx.ChannelID, ctx.Text))
+		},
+	})
+
+	RegisterAction(Command{
+		Name:        "Previous",
+		ActionID:    ActionPrevious,
+		NeedChannel: true,
+		Reply:       ReplyEphemeral,
+		Handler:     pageResult,
+	})
+
+	RegisterAction(Command{
+		Name:        "Next",
+		ActionID:    ActionNext,
+		NeedChannel: true,
+		Reply:       ReplyEphemeral,
+		Handler:     pageResult,
+	})
+
+	RegisterAction(Command{
+		Name:        "Restore",
+		ActionID:    ActionRestore,
+		NeedChannel: true,
+		Undoable:    true,
+		Handler: func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(RestoreRCA(ctx.UserName, ctx.Text, ctx.ChannelID))
+		},
+	})
+}
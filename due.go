@@ -0,0 +1,228 @@
+package main
+
+import (
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	cron "github.com/robfig/cron/v3"
+)
+
+const (
+	DueDateFormat = "2006-01-02"
+
+	DefaultOverdueCronInterval = "0 9 * * *" //daily
+)
+
+var (
+	overdueCronTask *cron.Cron
+
+	slackUserIDRegex = regexp.MustCompile(`^[UW][A-Z0-9]{8,}$`)
+)
+
+// ParseDueDate accepts YYYY-MM-DD, empty text, "-" and "none" clear the date.
+func ParseDueDate(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || text == "-" || strings.ToLower(text) == "none" {
+		return "", nil
+	}
+
+	due, err := time.ParseInLocation(DueDateFormat, text, time.Local)
+	if err != nil {
+		return "", MsgError("due.invalid", text, time.Now().AddDate(0, 0, 7).Format(DueDateFormat))
+	}
+
+	return due.Format(DueDateFormat), nil
+}
+
+// IsOverdue reports whether an active item is past its due date. Dates are
+// compared as YYYY-MM-DD strings in the server timezone.
+func IsOverdue(is RCAData, now time.Time) bool {
+	if is.DueDate == "" || !is.Status.IsActive() {
+		return false
+	}
+
+	return is.DueDate < now.Format(DueDateFormat)
+}
+
+func SetDue(uname, channelID, text string) (string, error) {
+	desc := strings.Fields(text)
+
+	if len(desc) < 2 {
+		return "", MsgError("due.command_invalid")
+	}
+
+	due, err := ParseDueDate(desc[1])
+	if err != nil {
+		return "", err
+	}
+
+	issueID, v, err := FindRCA(channelID, desc[0])
+	if err != nil {
+		return "", err
+	}
+
+	if v.Title == "" {
+		return "", MsgError("rca.invalid_id", desc[0], Msg("action.set_due"), uname)
+	}
+
+	oldDue := ""
+	err = Store.UpdateRCA(channelID, issueID, func(data *RCAData) error {
+		if data.Title == "" {
+			return ErrRCANotFound
+		}
+
+		oldDue = data.DueDate
+		data.DueDate = due
+		return nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	RecordRCAEvent(channelID, issueID, uname, "/setdue", "DueDate", oldDue, due)
+
+	lang := ChannelLanguage(channelID)
+	if due == "" {
+		return T(lang, "due.cleared", v.Title, v.DisplayID(issueID), uname), nil
+	}
+
+	return T(lang, "due.set", v.Title, v.DisplayID(issueID), due, uname), nil
+}
+
+func RegisterOverdueCron() {
+	interval := os.Getenv("RCA_OVERDUE_REMINDER_CRON")
+	if interval == "" {
+		interval = DefaultOverdueCronInterval
+	}
+
+	c := &Cron{
+		listenErrCh: make(chan error),
+	}
+
+	c.register(Job{
+		Interval: interval,
+		Handler: func() {
+			NotifyOverdueRCA(time.Now())
+		},
+	})
+
+	overdueCronTask = cron.New()
+	c.Run(overdueCronTask, false)
+}
+
+func NotifyOverdueRCA(now time.Time) {
+	channels, err := GetAllRCAData()
+	if err != nil {
+		Println(nil, "OVERDUE REMINDER GET RCA DATA ERROR, err: ", err)
+		return
+	}
+
+	userIDs := LoadSlackUserIDs()
+
+	for channelID, ch := range channels {
+		if !CanPostTo(ch) {
+			continue
+		}
+
+		slackMsg, ok := ConstructOverdueReminder(ch, now, userIDs)
+		if !ok {
+			continue
+		}
+
+		if err := NotifySlack(slackMsg, channelID, ch.ChannelKey); err != nil {
+			Println(nil, "OVERDUE REMINDER NOTIFY SLACK ERROR, err: ", err)
+		}
+	}
+}
+
+// LoadSlackUserIDs maps the lowercase user and display names of the workspace
+// to user IDs, so a typed assignee can be pinged. It is nil without a bot
+// token or when users.list fails.
+func LoadSlackUserIDs() map[string]string {
+	if slackAPI == nil {
+		return nil
+	}
+
+	users, err := slackAPI.ListUsers()
+	if err != nil {
+		Println(nil, "OVERDUE REMINDER USERS LIST ERROR, err: ", err)
+		return nil
+	}
+
+	userIDs := map[string]string{}
+	for _, u := range users {
+		if u.Deleted {
+			continue
+		}
+
+		for _, name := range []string{u.Name, u.Profile.DisplayName} {
+			if name = NormalizeAssignee(name); name != "" {
+				userIDs[name] = u.ID
+			}
+		}
+	}
+
+	return userIDs
+}
+
+// MentionAssignee turns an assignee into a <@U...> mention that pings the
+// user, false when it does not name a known Slack user.
+func MentionAssignee(assignee string, userIDs map[string]string) (string, bool) {
+	if m := slackMentionRegex.FindStringSubmatch(assignee); m != nil {
+		return "<@" + m[1] + ">", true
+	}
+
+	if id := strings.TrimPrefix(strings.TrimSpace(assignee), "@"); slackUserIDRegex.MatchString(id) {
+		return "<@" + id + ">", true
+	}
+
+	if id, ok := userIDs[NormalizeAssignee(assignee)]; ok {
+		return "<@" + id + ">", true
+	}
+
+	return assignee, false
+}
+
+func ConstructOverdueReminder(v Channel, now time.Time, userIDs map[string]string) (SlackMsgStructure, bool) {
+	lang := v.Lang()
+	slackMsg := SlackMsgStructure{}
+
+	issueKeys := []string{}
+	for issueID, is := range v.Data {
+		if IsOverdue(is, now) && !IsSnoozed(is, now) {
+			issueKeys = append(issueKeys, issueID)
+		}
+	}
+
+	if len(issueKeys) == 0 {
+		return slackMsg, false
+	}
+
+	sort.Slice(issueKeys, func(i, j int) bool {
+		return v.Data[issueKeys[i]].DueDate < v.Data[issueKeys[j]].DueDate
+	})
+
+	lines := []string{}
+	for _, issueID := range issueKeys {
+		is := v.Data[issueID]
+		mention, ok := MentionAssignee(is.Assignee, userIDs)
+
+		line := T(lang, "due.reminder_item", mention, is.Title, is.DisplayID(issueID), is.DueDate)
+		if !ok {
+			line += T(lang, "due.reminder_unresolved", is.Assignee)
+		}
+		lines = append(lines, line)
+	}
+
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(T(lang, "due.reminder_title", len(issueKeys))))
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackDividerBlock())
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackTextBlocks(lines)...)
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackDividerBlock())
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(T(lang, "due.reminder_footer")))
+
+	return AppendFootNotes(lang, slackMsg), true
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RCAFieldChange is one field changed by /editrca.
+type RCAFieldChange struct {
+	Field    RCAField
+	OldValue string
+	NewValue string
+}
+
+// RCAFieldEdit is one field=value flag of /editrca.
+type RCAFieldEdit struct {
+	Field RCAField
+	Value string
+}
+
+// ParseRCAFieldEdits reads the field=value flags of /editrca, every token has
+// to be a known field and each field may only be given once.
+func ParseRCAFieldEdits(tokens []ArgToken) ([]RCAFieldEdit, error) {
+	edits := []RCAFieldEdit{}
+	seen := map[string]int{} //field -> column
+
+	for _, t := range tokens {
+		if t.Key == "" {
+			return nil, MsgError("edit.unexpected", t.Value, t.Col)
+		}
+
+		f, ok := FindRCAField(t.Key)
+		if !ok {
+			return nil, MsgError("edit.unknown_field", t.Key, t.Col, strings.Join(RCAFieldNames(), ", "))
+		}
+
+		if col, ok := seen[f.Name]; ok {
+			return nil, MsgError("args.duplicate", f.Name, t.Col, col)
+		}
+		seen[f.Name] = t.Col
+
+		// validate up front so the error can point at the column
+		var probe RCAData
+		if err := f.Set(&probe, t.Value); err != nil {
+			return nil, MsgError("args.invalid_value", f.Name, t.Col, err)
+		}
+
+		edits = append(edits, RCAFieldEdit{Field: f, Value: t.Value})
+	}
+
+	if len(edits) == 0 {
+		return nil, MsgError("edit.nothing", strings.Join(RCAFieldNames(), ", "))
+	}
+
+	return edits, nil
+}
+
+// ApplyRCAFieldEdits sets the edits on data and returns the fields whose
+// value actually changed.
+func ApplyRCAFieldEdits(data *RCAData, edits []RCAFieldEdit) ([]RCAFieldChange, error) {
+	changes := []RCAFieldChange{}
+
+	for _, e := range edits {
+		old := e.Field.Get(*data)
+		if err := e.Field.Set(data, e.Value); err != nil {
+			return nil, err
+		}
+
+		if now := e.Field.Get(*data); now != old {
+			changes = append(changes, RCAFieldChange{Field: e.Field, OldValue: old, NewValue: now})
+		}
+	}
+
+	return changes, nil
+}
+
+// EditRCA edits one RCA, or several when more IDs or a selector are given.
+// Targets and edits are split by a bare `set`, without it the leading bare
+// tokens are the IDs and every flag is an edit.
+func EditRCA(uname, channelID, text string) (string, error) {
+	tokens, err := TokenizeArgs(text)
+	if err != nil {
+		return "", err
+	}
+
+	targetTokens, editTokens := splitEditTokens(tokens)
+
+	targets, err := ParseBulkTargets(targetTokens)
+	if err != nil {
+		return "", err
+	}
+
+	if targets.IsEmpty() {
+		return "", MsgError("edit.invalid")
+	}
+
+	edits, err := ParseRCAFieldEdits(editTokens)
+	if err != nil {
+		return "", err
+	}
+
+	if !targets.IsSingle() {
+		return BulkEditRCA(uname, channelID, targets, edits)
+	}
+
+	return editSingleRCA(uname, channelID, targets.IDs[0], edits)
+}
+
+func splitEditTokens(tokens []ArgToken) ([]ArgToken, []ArgToken) {
+	for i, t := range tokens {
+		if t.Key == "" && strings.ToLower(t.Value) == "set" {
+			return tokens[:i], tokens[i+1:]
+		}
+	}
+
+	i := 0
+	for i < len(tokens) && tokens[i].Key == "" {
+		i++
+	}
+
+	return tokens[:i], tokens[i:]
+}
+
+func editSingleRCA(uname, channelID, id string, edits []RCAFieldEdit) (string, error) {
+	issueID, v, err := FindRCA(channelID, id)
+	if err != nil {
+		return "", err
+	}
+
+	if v.Title == "" {
+		return "", MsgError("rca.invalid_id", id, Msg("action.edit"), uname)
+	}
+
+	changes := []RCAFieldChange{}
+	err = Store.UpdateRCA(channelID, issueID, func(data *RCAData) error {
+		if data.Title == "" {
+			return ErrRCANotFound
+		}
+
+		var applyErr error
+		changes, applyErr = ApplyRCAFieldEdits(data, edits)
+		return applyErr
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(changes) == 0 {
+		return "", MsgError("edit.unchanged", v.Title, v.DisplayID(issueID))
+	}
+
+	for _, c := range changes {
+		RecordRCAEvent(channelID, issueID, uname, "/editrca", c.Field.Label, c.OldValue, c.NewValue)
+	}
+
+	lang := ChannelLanguage(channelID)
+	return T(lang, "edit.done", v.Title, v.DisplayID(issueID), uname) + "\n" + FormatRCAFieldChanges(lang, changes), nil
+}
+
+func FormatRCAFieldChanges(lang Language, changes []RCAFieldChange) string {
+	lines := []string{}
+	for _, c := range changes {
+		lines = append(lines, fmt.Sprintf("• *%s*: %s → %s", c.Field.Name, changeValue(lang, c.OldValue), changeValue(lang, c.NewValue)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func changeValue(lang Language, value string) string {
+	if value == "" {
+		return T(lang, "edit.empty")
+	}
+
+	return value
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	tagRegex = regexp.MustCompile(`^[\p{L}\p{N}_-]+$`)
+)
+
+// RCAField describes one user editable RCAData field. Set validates and
+// normalizes the value, Get renders it back for messages and history. Label
+// is the RCAData field name used in the history.
+type RCAField struct {
+	Name    string
+	Label   string
+	Aliases []string
+	Set     func(data *RCAData, value string) error
+	Get     func(data RCAData) string
+}
+
+// RCAFields is also the order of positional /addrca arguments.
+var RCAFields = []RCAField{
+	{
+		Name:  "title",
+		Label: "Title",
+		Set: func(data *RCAData, value string) error {
+			if strings.TrimSpace(value) == "" {
+				return MsgError("field.title_empty")
+			}
+			data.Title = value
+			return nil
+		},
+		Get: func(data RCAData) string { return data.Title },
+	},
+	{
+		Name:    "desc",
+		Label:   "Description",
+		Aliases: []string{"description"},
+		Set: func(data *RCAData, value string) error {
+			data.Description = value
+			return nil
+		},
+		Get: func(data RCAData) string { return data.Description },
+	},
+	{
+		Name:  "assignee",
+		Label: "Assignee",
+		Set: func(data *RCAData, value string) error {
+			if strings.TrimSpace(value) == "" {
+				return MsgError("field.assignee_empty")
+			}
+			data.Assignee = value
+			return nil
+		},
+		Get: func(data RCAData) string { return data.Assignee },
+	},
+	{
+		Name:  "pma",
+		Label: "PMA",
+		Set: func(data *RCAData, value string) error {
+			data.PMA = value
+			return nil
+		},
+		Get: func(data RCAData) string { return data.PMA },
+	},
+	{
+		Name:    "env",
+		Label:   "Environment",
+		Aliases: []string{"environment"},
+		Set: func(data *RCAData, value string) error {
+			env, err := ParseEnvironment(value)
+			if err != nil {
+				return err
+			}
+			data.Environment = env
+			return nil
+		},
+		Get: func(data RCAData) string { return data.Environment },
+	},
+	{
+		Name:    "sev",
+		Label:   "Severity",
+		Aliases: []string{"severity"},
+		Set: func(data *RCAData, value string) error {
+			sev, err := ParseSeverity(value)
+			if err != nil {
+				return err
+			}
+			data.Severity = sev
+			return nil
+		},
+		Get: func(data RCAData) string { return SeverityLabel(data.Severity) },
+	},
+	{
+		Name:    "users",
+		Label:   "AffectedUsers",
+		Aliases: []string{"affected", "affected_users"},
+		Set: func(data *RCAData, value string) error {
+			data.AffectedUsers = value
+			return nil
+		},
+		Get: func(data RCAData) string { return data.AffectedUsers },
+	},
+	{
+		Name:  "duration",
+		Label: "Duration",
+		Set: func(data *RCAData, value string) error {
+			data.Duration = value
+			return nil
+		},
+		Get: func(data RCAData) string { return data.Duration },
+	},
+	{
+		Name:    "impact",
+		Label:   "BusinessImpact",
+		Aliases: []string{"business_impact"},
+		Set: func(data *RCAData, value string) error {
+			data.BusinessImpact = value
+			return nil
+		},
+		Get: func(data RCAData) string { return data.BusinessImpact },
+	},
+	{
+		Name:  "due",
+		Label: "DueDate",
+		Set: func(data *RCAData, value string) error {
+			due, err := ParseDueDate(value)
+			if err != nil {
+				return err
+			}
+			data.DueDate = due
+			return nil
+		},
+		Get: func(data RCAData) string { return data.DueDate },
+	},
+	{
+		Name:    "tags",
+		Label:   "Tags",
+		Aliases: []string{"tag"},
+		Set: func(data *RCAData, value string) error {
+			tags, err := ParseTags(value)
+			if err != nil {
+				return err
+			}
+			data.Tags = tags
+			return nil
+		},
+		Get: func(data RCAData) string { return strings.Join(data.Tags, ",") },
+	},
+}
+
+func FindRCAField(name string) (RCAField, bool) {
+	name = strings.ToLower(name)
+
+	for _, f := range RCAFields {
+		if f.Name == name {
+			return f, true
+		}
+
+		for _, alias := range f.Aliases {
+			if alias == name {
+				return f, true
+			}
+		}
+	}
+
+	return RCAField{}, false
+}
+
+func RCAFieldNames() []string {
+	names := []string{}
+	for _, f := range RCAFields {
+		names = append(names, f.Name)
+	}
+
+	return names
+}
+
+func ParseEnvironment(text string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(text)) {
+	case "", "-", "production", "prod":
+		return "Production", nil
+	case "staging", "stg":
+		return "Staging", nil
+	}
+
+	return "", MsgError("field.invalid_env", text)
+}
+
+// ParseTags reads a comma separated tag list, tags are kept lowercase without
+// a leading # and duplicates are dropped. "-" or empty text clears the tags.
+func ParseTags(text string) ([]string, error) {
+	tags := []string{}
+	if strings.TrimSpace(text) == "-" {
+		return tags, nil
+	}
+
+	seen := map[string]bool{}
+	for _, tag := range strings.Split(text, ",") {
+		tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+		if tag == "" || seen[tag] {
+			continue
+		}
+
+		if !tagRegex.MatchString(tag) {
+			return nil, MsgError("field.invalid_tag", tag)
+		}
+
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
+
+func FormatTags(tags []string) string {
+	formatted := []string{}
+	for _, tag := range tags {
+		formatted = append(formatted, "`#"+tag+"`")
+	}
+
+	return strings.Join(formatted, " ")
+}
+
+// ParseAddRCAArgs builds a new RCA from /addrca text. Positional arguments
+// follow the legacy order (title desc assignee pma env sev users duration
+// impact due tags), `-` skips one, and key=value flags may be mixed in.
+func ParseAddRCAArgs(text string) (RCAData, error) {
+	data := RCAData{
+		Environment: "Production",
+		Status:      StatusOpen,
+	}
+
+	tokens, err := TokenizeArgs(text)
+	if err != nil {
+		return data, err
+	}
+
+	seen := map[string]int{} //field -> column
+	pos := 0
+
+	for _, t := range tokens {
+		var f RCAField
+
+		if t.Key == "" {
+			if pos >= len(RCAFields) {
+				return data, MsgError("args.too_many", t.Value, t.Col)
+			}
+
+			f = RCAFields[pos]
+			pos++
+
+			if t.Value == "-" {
+				continue
+			}
+		} else {
+			var ok bool
+			if f, ok = FindRCAField(t.Key); !ok {
+				return data, MsgError("args.unknown_flag", t.Key, t.Col, strings.Join(RCAFieldNames(), ", "))
+			}
+		}
+
+		if col, ok := seen[f.Name]; ok {
+			return data, MsgError("args.duplicate", f.Name, t.Col, col)
+		}
+		seen[f.Name] = t.Col
+
+		if err := f.Set(&data, t.Value); err != nil {
+			return data, MsgError("args.invalid_value", f.Name, t.Col, err)
+		}
+	}
+
+	for _, name := range []string{"title", "assignee"} {
+		if _, ok := seen[name]; !ok {
+			return data, MsgError("add.missing", name)
+		}
+	}
+
+	return data, nil
+}
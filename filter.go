@@ -0,0 +1,344 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	severityFilterRegex = regexp.MustCompile(`^sev(>=|<=|=|>|<)(sev)?([0-9]+)$`)
+	ageFilterRegex      = regexp.MustCompile(`^([0-9]+)([hdw])$`)
+)
+
+// RCAFilter narrows a list command. Severity comparisons follow how serious
+// an incident is, so sev>=2 keeps SEV1 and SEV2. Assignees match any of the
+// given names. Since and Until compare the day an item was done, or created
+// when it is not done.
+type RCAFilter struct {
+	SeverityOp  string
+	Severity    int
+	Assignees   []string
+	Environment string
+	Tag         string
+	OlderThan   time.Duration
+	Since       string //YYYY-MM-DD, inclusive
+	Until       string
+
+	Now time.Time //reference for OlderThan
+}
+
+func ParseRCAFilter(text string) (RCAFilter, error) {
+	f, page, err := ParseListArgs(text)
+	if err == nil && page != 0 {
+		err = MsgError("filter.page_not_paged")
+	}
+
+	return f, err
+}
+
+// ParseListArgs reads the filters of a list command plus an optional page=N,
+// page is 0 when it is not given.
+func ParseListArgs(text string) (RCAFilter, int, error) {
+	f := RCAFilter{Now: time.Now()}
+	page := 0
+
+	tokens, err := TokenizeArgs(text)
+	if err != nil {
+		return f, page, err
+	}
+
+	for _, t := range tokens {
+		if t.Key == "page" {
+			if page, err = strconv.Atoi(t.Value); err != nil || page < 1 {
+				return f, page, MsgError("args.invalid_page", t.Value, t.Col)
+			}
+			continue
+		}
+
+		if err := f.set(t); err != nil {
+			return f, page, err
+		}
+	}
+
+	return f, page, nil
+}
+
+func (f *RCAFilter) set(t ArgToken) error {
+	switch t.Key {
+	case "":
+		match := severityFilterRegex.FindStringSubmatch(strings.ToLower(t.Value))
+		if match == nil {
+			return MsgError("filter.invalid", t.Value)
+		}
+
+		sev, err := ParseSeverity(match[3])
+		if err != nil {
+			return err
+		}
+
+		f.SeverityOp = match[1]
+		f.Severity = sev
+
+	case "sev", "severity":
+		sev, err := ParseSeverity(t.Value)
+		if err != nil {
+			return err
+		}
+
+		f.SeverityOp = "="
+		f.Severity = sev
+
+	case "assignee":
+		if NormalizeAssignee(t.Value) == "" {
+			return MsgError("filter.empty_assignee", t.Col)
+		}
+		f.Assignees = append(f.Assignees, t.Value)
+
+	case "env", "environment":
+		env, err := ParseEnvironment(t.Value)
+		if err != nil {
+			return err
+		}
+		f.Environment = env
+
+	case "tag":
+		tags, err := ParseTags(t.Value)
+		if err != nil {
+			return err
+		}
+
+		if len(tags) != 1 {
+			return MsgError("filter.one_tag", t.Value, t.Col)
+		}
+		f.Tag = tags[0]
+
+	case "since", "until":
+		date, err := ParseDueDate(t.Value)
+		if err != nil || date == "" {
+			return MsgError("filter.invalid_date", t.Key, t.Value, t.Col)
+		}
+
+		if t.Key == "since" {
+			f.Since = date
+		} else {
+			f.Until = date
+		}
+
+	case "older-than":
+		age, err := ParseAge(t.Value)
+		if err != nil {
+			return err
+		}
+		f.OlderThan = age
+
+	default:
+		return MsgError("filter.unknown", t.Key, t.Col)
+	}
+
+	return nil
+}
+
+// ParseAge reads durations like 12h, 7d or 2w.
+func ParseAge(text string) (time.Duration, error) {
+	match := ageFilterRegex.FindStringSubmatch(strings.ToLower(strings.TrimSpace(text)))
+	if match == nil {
+		return 0, MsgError("filter.invalid_age", text)
+	}
+
+	n, _ := strconv.Atoi(match[1])
+	unit := map[string]time.Duration{
+		"h": time.Hour,
+		"d": 24 * time.Hour,
+		"w": 7 * 24 * time.Hour,
+	}[match[2]]
+
+	return time.Duration(n) * unit, nil
+}
+
+// NormalizeAssignee reduces "@Budi", "budi" and Slack's escaped "<@U123|budi>"
+// to "budi" so they compare equal.
+func NormalizeAssignee(text string) string {
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, "<@") && strings.HasSuffix(text, ">") {
+		text = strings.TrimSuffix(strings.TrimPrefix(text, "<@"), ">")
+		if i := strings.Index(text, "|"); i >= 0 {
+			text = text[i+1:]
+		}
+	}
+
+	return strings.ToLower(strings.TrimPrefix(text, "@"))
+}
+
+// AssigneeMatches reports whether assignee is any of names. The raw Slack ID
+// of an escaped mention counts as a name too.
+func AssigneeMatches(assignee string, names ...string) bool {
+	candidates := []string{NormalizeAssignee(assignee)}
+	if strings.HasPrefix(assignee, "<@") {
+		id := strings.SplitN(strings.TrimSuffix(strings.TrimPrefix(assignee, "<@"), ">"), "|", 2)[0]
+		candidates = append(candidates, strings.ToLower(id))
+	}
+
+	for _, name := range names {
+		name = NormalizeAssignee(name)
+		for _, c := range candidates {
+			if name != "" && name == c {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (f RCAFilter) IsEmpty() bool {
+	return f.SeverityOp == "" && len(f.Assignees) == 0 && f.Environment == "" && f.Tag == "" && f.OlderThan == 0 && f.Since == "" && f.Until == ""
+}
+
+func (f RCAFilter) Match(issueID string, is RCAData) bool {
+	if f.SeverityOp != "" {
+		if is.Severity == 0 {
+			return false
+		}
+
+		// a lower SEV number is more serious, so the comparison is flipped
+		switch f.SeverityOp {
+		case ">=":
+			if is.Severity > f.Severity {
+				return false
+			}
+		case "<=":
+			if is.Severity < f.Severity {
+				return false
+			}
+		case ">":
+			if is.Severity >= f.Severity {
+				return false
+			}
+		case "<":
+			if is.Severity <= f.Severity {
+				return false
+			}
+		case "=":
+			if is.Severity != f.Severity {
+				return false
+			}
+		}
+	}
+
+	if len(f.Assignees) > 0 && !AssigneeMatches(is.Assignee, f.Assignees...) {
+		return false
+	}
+
+	if f.Environment != "" {
+		env := is.Environment
+		if env != "Staging" {
+			env = "Production"
+		}
+
+		if env != f.Environment {
+			return false
+		}
+	}
+
+	if f.Tag != "" && !hasTag(is.Tags, f.Tag) {
+		return false
+	}
+
+	if f.OlderThan != 0 {
+		created := RCACreatedAt(issueID, is)
+		if created == 0 || time.Unix(0, created).After(f.Now.Add(-f.OlderThan)) {
+			return false
+		}
+	}
+
+	if f.Since != "" || f.Until != "" {
+		day := time.Unix(0, RCACreatedAt(issueID, is)).Format(DueDateFormat)
+		if is.Status == StatusDone {
+			day = RCADoneAt(issueID, is).Format(DueDateFormat)
+		}
+
+		if (f.Since != "" && day < f.Since) || (f.Until != "" && day > f.Until) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f RCAFilter) String() string {
+	parts := []string{}
+
+	if f.SeverityOp != "" {
+		parts = append(parts, fmt.Sprintf("sev%s%d", f.SeverityOp, f.Severity))
+	}
+
+	for _, a := range f.Assignees {
+		parts = append(parts, "assignee="+a)
+	}
+
+	if f.Environment != "" {
+		parts = append(parts, "env="+strings.ToLower(f.Environment))
+	}
+
+	if f.Tag != "" {
+		parts = append(parts, "tag="+f.Tag)
+	}
+
+	if f.OlderThan != 0 {
+		parts = append(parts, fmt.Sprintf("older-than=%dh", int(f.OlderThan.Hours())))
+	}
+
+	if f.Since != "" {
+		parts = append(parts, "since="+f.Since)
+	}
+
+	if f.Until != "" {
+		parts = append(parts, "until="+f.Until)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// FilterRCAData returns a copy of the channel holding only matching items.
+func FilterRCAData(v Channel, f RCAFilter) Channel {
+	if f.IsEmpty() {
+		return v
+	}
+
+	filtered := v
+	filtered.Data = map[string]RCAData{}
+
+	for issueID, is := range v.Data {
+		if f.Match(issueID, is) {
+			filtered.Data[issueID] = is
+		}
+	}
+
+	return filtered
+}
+
+func ListRCA(uname, text string, channelData Channel, status RCAStatus) (SlackMsgStructure, error) {
+	f, page, err := ParseListArgs(text)
+	if err != nil {
+		return SlackMsgStructure{}, err
+	}
+
+	if page == 0 {
+		page = 1
+	}
+
+	return ConstructRCADataString(FilterRCAData(channelData, f), status, uname, page, f.String()), nil
+}
@@ -6,11 +6,11 @@ require (
 	cloud.google.com/go/firestore v1.4.0 // indirect
 	firebase.google.com/go v3.13.0+incompatible
 	github.com/codegangsta/negroni v1.0.0
-	github.com/google/uuid v1.2.0
 	github.com/julienschmidt/httprouter v1.3.0
 	github.com/robfig/cron/v3 v3.0.1
 	github.com/sirupsen/logrus v1.8.0
 	github.com/valyala/fasthttp v1.21.0 // indirect
+	go.etcd.io/bbolt v1.3.6
 	google.golang.org/api v0.40.0
 	gopkg.in/tokopedia/grace.v1 v1.0.0-20170803113110-f3242e67d9aa
 	gopkg.in/tylerb/graceful.v1 v1.2.15 // indirect
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	HistoryTimeFormat = "2006-01-02 15:04 MST"
+	HistoryPageSize   = 25
+)
+
+// RCAEvent is one append-only entry of an RCA audit trail, stored under
+// History/{channelID}/{issueID}.
+type RCAEvent struct {
+	User     string
+	Time     int64
+	Command  string
+	Field    string
+	OldValue string
+	NewValue string
+
+	//Event is the catalog code of a change that is not a field update, shown
+	//as history.event.<Event> with Args in the reader's language
+	Event string   `json:",omitempty"`
+	Args  []string `json:",omitempty"`
+}
+
+func RecordRCAEvent(channelID, issueID, uname, command, field, oldValue, newValue string) {
+	appendRCAEvent(channelID, issueID, RCAEvent{
+		User:     uname,
+		Command:  command,
+		Field:    field,
+		OldValue: oldValue,
+		NewValue: newValue,
+	})
+}
+
+// RecordRCANote records a change by its event code, e.g. created, the text
+// is rendered when the history is shown.
+func RecordRCANote(channelID, issueID, uname, command, event string, args ...string) {
+	appendRCAEvent(channelID, issueID, RCAEvent{
+		User:    uname,
+		Command: command,
+		Event:   event,
+		Args:    args,
+	})
+}
+
+func appendRCAEvent(channelID, issueID string, ev RCAEvent) {
+	ev.Time = time.Now().Unix()
+	uname := ev.User
+
+	if err := Store.AppendRCAEvent(channelID, issueID, ev); err != nil {
+		Println(nil, "RECORD RCA HISTORY ERROR, err: ", err)
+	}
+
+	TouchUndo(uname, channelID, issueID)
+}
+
+func SortRCAEvents(events []RCAEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time < events[j].Time
+	})
+}
+
+// RCAHistory shows one page of the trail, the latest page unless page=N
+// asks for an older one.
+func RCAHistory(uname, channelID, text string) (SlackMsgStructure, error) {
+	slackMsg := SlackMsgStructure{}
+
+	tokens, err := TokenizeArgs(text)
+	if err != nil {
+		return slackMsg, err
+	}
+
+	id, page := "", 0
+	for _, t := range tokens {
+		switch {
+		case t.Key == "page":
+			if page, err = strconv.Atoi(t.Value); err != nil || page < 1 {
+				return slackMsg, MsgError("args.invalid_page", t.Value, t.Col)
+			}
+		case t.Key == "" && id == "":
+			id = t.Value
+		default:
+			return slackMsg, MsgError("history.invalid")
+		}
+	}
+
+	if id == "" {
+		return slackMsg, MsgError("history.invalid")
+	}
+
+	issueID, v, err := FindRCA(channelID, id)
+	if err != nil {
+		return slackMsg, err
+	}
+
+	if v.Title == "" {
+		return slackMsg, MsgError("rca.invalid_id", id, Msg("action.show_history"), uname)
+	}
+
+	events, err := Store.GetRCAHistory(channelID, issueID)
+	if err != nil {
+		return slackMsg, err
+	}
+
+	return ConstructRCAHistoryString(ChannelLanguage(channelID), v.DisplayID(issueID), v, events, uname, page), nil
+}
+
+// ConstructRCAHistoryString renders HistoryPageSize events per page, page 0
+// is the latest one.
+func ConstructRCAHistoryString(lang Language, issueID string, v RCAData, events []RCAEvent, uname string, page int) SlackMsgStructure {
+	slackMsg := SlackMsgStructure{}
+
+	pages := (len(events) + HistoryPageSize - 1) / HistoryPageSize
+	if page < 1 || page > pages {
+		page = pages
+	}
+
+	title := T(lang, "history.title", uname, v.Title, issueID)
+	if pages > 1 {
+		title += T(lang, "history.page", page, pages, len(events))
+	}
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(title))
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackDividerBlock())
+
+	if len(events) == 0 {
+		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(T(lang, "history.empty")))
+		return AppendFootNotes(lang, slackMsg)
+	}
+
+	end := page * HistoryPageSize
+	if end > len(events) {
+		end = len(events)
+	}
+
+	lines := []string{}
+	for _, ev := range events[(page-1)*HistoryPageSize : end] {
+		lines = append(lines, FormatRCAEvent(lang, ev))
+	}
+
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackTextBlocks(lines)...)
+
+	if pages > 1 {
+		slackMsg.Blocks = append(slackMsg.Blocks, GetPageNavBlock(lang, "/rcahistory", page, pages, issueID))
+	}
+
+	return AppendFootNotes(lang, slackMsg)
+}
+
+func FormatRCAEvent(lang Language, ev RCAEvent) string {
+	when := time.Unix(ev.Time, 0).Format(HistoryTimeFormat)
+	change := T(lang, "history.set", ev.Field, ev.NewValue)
+
+	switch {
+	case ev.Event != "":
+		args := make([]interface{}, len(ev.Args))
+		for i, arg := range ev.Args {
+			args[i] = arg
+		}
+		change = T(lang, "history.event."+ev.Event, args...)
+	case ev.Field == "":
+		change = ev.NewValue //free text of events recorded before Event
+	case ev.OldValue != "":
+		change = T(lang, "history.changed", ev.Field, ev.OldValue, ev.NewValue)
+	}
+
+	return fmt.Sprintf("• `%s` *%s* `%s` - %s", when, ev.User, ev.Command, change)
+}
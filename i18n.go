@@ -0,0 +1,180 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type Language string
+
+const (
+	LangEnglish    Language = "en"
+	LangIndonesian Language = "id"
+
+	DefaultLanguage = LangEnglish
+)
+
+var (
+	Languages = []Language{LangEnglish, LangIndonesian}
+
+	channelLangMtx sync.RWMutex
+	channelLang    = map[string]Language{} //channelID -> language, filled on first use
+)
+
+func ParseLanguage(text string) (Language, error) {
+	text = strings.ToLower(strings.TrimSpace(text))
+
+	for _, lang := range Languages {
+		if string(lang) == text {
+			return lang, nil
+		}
+	}
+
+	return "", MsgError("lang.invalid", text, LanguageNames())
+}
+
+func LanguageNames() string {
+	names := []string{}
+	for _, lang := range Languages {
+		names = append(names, string(lang))
+	}
+
+	return strings.Join(names, "|")
+}
+
+// T renders a catalog message in lang, falling back to English and then to
+// the key itself so a missing entry still shows something useful. Arguments
+// that are LocalizedText or errors are rendered in lang too.
+func T(lang Language, key string, args ...interface{}) string {
+	entry, ok := messageCatalog[key]
+	if !ok {
+		return key
+	}
+
+	format, ok := entry[lang]
+	if !ok {
+		format = entry[DefaultLanguage]
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+
+	rendered := make([]interface{}, len(args))
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case LocalizedText:
+			rendered[i] = v.Localize(lang)
+		case error:
+			rendered[i] = LocalizeError(lang, v)
+		default:
+			rendered[i] = arg
+		}
+	}
+
+	return fmt.Sprintf(format, rendered...)
+}
+
+// LocalizedText is a catalog message rendered later, once the language of
+// the reader is known. String() is English.
+type LocalizedText struct {
+	Key  string
+	Args []interface{}
+}
+
+func Msg(key string, args ...interface{}) LocalizedText {
+	return LocalizedText{Key: key, Args: args}
+}
+
+func (m LocalizedText) String() string {
+	return m.Localize(DefaultLanguage)
+}
+
+func (m LocalizedText) Localize(lang Language) string {
+	return T(lang, m.Key, m.Args...)
+}
+
+// LocalizedError is an error kept as a catalog message, ExecuteCommand
+// renders it in the channel language.
+type LocalizedError struct {
+	LocalizedText
+}
+
+func MsgError(key string, args ...interface{}) error {
+	return &LocalizedError{Msg(key, args...)}
+}
+
+func (e *LocalizedError) Error() string {
+	return e.String()
+}
+
+func LocalizeError(lang Language, err error) string {
+	var lerr *LocalizedError
+	if errors.As(err, &lerr) {
+		return lerr.Localize(lang)
+	}
+
+	return err.Error()
+}
+
+// ChannelLanguage returns the language chosen with /setlanguage, cached after
+// the first lookup.
+func ChannelLanguage(channelID string) Language {
+	if channelID == "" {
+		return DefaultLanguage
+	}
+
+	channelLangMtx.RLock()
+	lang, ok := channelLang[channelID]
+	channelLangMtx.RUnlock()
+
+	if ok {
+		return lang
+	}
+
+	ch, err := Store.GetChannel(channelID)
+	if err != nil {
+		return DefaultLanguage
+	}
+
+	return cacheChannelLanguage(channelID, ch.Language)
+}
+
+// Lang is the language of an already loaded channel.
+func (ch Channel) Lang() Language {
+	lang, err := ParseLanguage(ch.Language)
+	if err != nil {
+		return DefaultLanguage
+	}
+
+	return lang
+}
+
+func cacheChannelLanguage(channelID, text string) Language {
+	lang, err := ParseLanguage(text)
+	if err != nil {
+		lang = DefaultLanguage
+	}
+
+	channelLangMtx.Lock()
+	channelLang[channelID] = lang
+	channelLangMtx.Unlock()
+
+	return lang
+}
+
+func SetLanguage(uname, channelID, text string) (string, error) {
+	lang, err := ParseLanguage(text)
+	if err != nil {
+		return "", err
+	}
+
+	if err := Store.SetLanguage(channelID, string(lang)); err != nil {
+		return "", err
+	}
+
+	cacheChannelLanguage(channelID, string(lang))
+	return T(lang, "lang.set", T(lang, "lang.name."+string(lang)), uname), nil
+}
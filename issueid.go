@@ -0,0 +1,157 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	IssueIDPrefix = "RCA-"
+)
+
+var (
+	shortIssueIDRegex = regexp.MustCompile(`^(?i:rca-?|#)?([0-9]+)$`)
+)
+
+// NewIssueID allocates the next per-channel sequential ID, e.g. RCA-42.
+func NewIssueID(channelID string) (string, error) {
+	seq, err := Store.NextIssueNumber(channelID)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s%d", IssueIDPrefix, seq), nil
+}
+
+// NormalizeIssueID turns "42", "#42" and "rca-42" into "RCA-42", anything
+// else (like the old timestamp-uuid IDs) is returned untouched.
+func NormalizeIssueID(text string) string {
+	text = strings.TrimSpace(text)
+
+	match := shortIssueIDRegex.FindStringSubmatch(text)
+	if match == nil {
+		return text
+	}
+
+	return IssueIDPrefix + match[1]
+}
+
+// FindRCA resolves a user supplied ID to the stored key, following the
+// alias kept for items created before short IDs existed.
+func FindRCA(channelID, text string) (string, RCAData, error) {
+	issueID := NormalizeIssueID(text)
+
+	v, err := Store.GetRCA(channelID, issueID)
+	if err != nil || v.Title != "" {
+		return issueID, v, err
+	}
+
+	ch, err := Store.GetChannel(channelID)
+	if err != nil {
+		return issueID, v, err
+	}
+
+	if target, ok := ch.Alias[issueID]; ok {
+		return target, ch.Data[target], nil
+	}
+
+	return issueID, v, nil
+}
+
+// DisplayID is the ID shown to users, the short ID when the item has one.
+func (v RCAData) DisplayID(issueID string) string {
+	if v.ShortID != "" {
+		return v.ShortID
+	}
+
+	return issueID
+}
+
+// RCACreatedAt returns the creation time in unix nano, old IDs start with
+// the creation timestamp so it is read from there.
+func RCACreatedAt(issueID string, v RCAData) int64 {
+	if v.CreatedAt != 0 {
+		return v.CreatedAt
+	}
+
+	nano, _ := strconv.ParseInt(strings.SplitN(issueID, "-", 2)[0], 10, 64)
+	return nano
+}
+
+// RCADoneAt is when the item was set to Done, items done before that was
+// recorded fall back to their creation time.
+func RCADoneAt(issueID string, v RCAData) time.Time {
+	if v.DoneAt != 0 {
+		return time.Unix(v.DoneAt, 0)
+	}
+
+	return time.Unix(0, RCACreatedAt(issueID, v))
+}
+
+// SortIssueKeysByCreated orders keys oldest first.
+func SortIssueKeysByCreated(keys []string, data map[string]RCAData) {
+	sort.SliceStable(keys, func(i, j int) bool {
+		ci, cj := RCACreatedAt(keys[i], data[keys[i]]), RCACreatedAt(keys[j], data[keys[j]])
+		if ci != cj {
+			return ci < cj
+		}
+		return keys[i] < keys[j]
+	})
+}
+
+// AssignLegacyShortIDs gives every item created before short IDs existed a
+// RCA-n ID in creation order, the old ID keeps working as the storage key.
+func AssignLegacyShortIDs() {
+	channels, err := GetAllRCAData()
+	if err != nil {
+		Println(nil, "ASSIGN SHORT ID GET RCA DATA ERROR, err: ", err)
+		return
+	}
+
+	for channelID, ch := range channels {
+		legacyKeys := []string{}
+		for issueID, is := range ch.Data {
+			if is.ShortID == "" && !strings.HasPrefix(issueID, IssueIDPrefix) {
+				legacyKeys = append(legacyKeys, issueID)
+			}
+		}
+
+		SortIssueKeysByCreated(legacyKeys, ch.Data)
+
+		for _, issueID := range legacyKeys {
+			if err := assignLegacyShortID(channelID, issueID); err != nil {
+				Println(nil, "ASSIGN SHORT ID ERROR, err: ", err)
+			}
+		}
+	}
+}
+
+func assignLegacyShortID(channelID, issueID string) error {
+	shortID, err := NewIssueID(channelID)
+	if err != nil {
+		return err
+	}
+
+	assigned := false
+	err = Store.UpdateRCA(channelID, issueID, func(data *RCAData) error {
+		if data.Title == "" {
+			return ErrRCANotFound
+		}
+
+		assigned = data.ShortID == ""
+		if assigned {
+			data.ShortID = shortID
+		}
+		return nil
+	})
+
+	if err != nil || !assigned {
+		return err
+	}
+
+	return Store.SetIssueAlias(channelID, shortID, issueID)
+}
@@ -0,0 +1,989 @@
+package main
+
+// messageCatalog holds every Slack facing text by key and language, English
+// is the fallback. help.<command> entries only need translations, the English
+// help lives in the command registration.
+var messageCatalog = map[string]map[Language]string{
+	// language
+	"lang.invalid": {
+		LangEnglish:    "Unknown language `%s`, use one of: %s",
+		LangIndonesian: "Bahasa `%s` tidak dikenal, gunakan salah satu: %s",
+	},
+	"lang.set": {
+		LangEnglish:    "_Bot language set to %s by %s_",
+		LangIndonesian: "_Bahasa bot diatur ke %s oleh %s_",
+	},
+	"lang.name.en": {
+		LangEnglish:    "English",
+		LangIndonesian: "English",
+	},
+	"lang.name.id": {
+		LangEnglish:    "Bahasa Indonesia",
+		LangIndonesian: "Bahasa Indonesia",
+	},
+
+	// generic errors
+	"error.bad_payload": {
+		LangEnglish:    "Sorry System Error: Bad Payload :(",
+		LangIndonesian: "Maaf, terjadi kesalahan sistem: payload tidak valid :(",
+	},
+	"error.unknown_action": {
+		LangEnglish:    "Sorry, unknown action `%s`",
+		LangIndonesian: "Maaf, aksi `%s` tidak dikenal",
+	},
+	"error.unknown_command": {
+		LangEnglish:    "Sorry, unknown command `%s`, see /internalrcahelp",
+		LangIndonesian: "Maaf, perintah `%s` tidak dikenal, lihat /internalrcahelp",
+	},
+	"failed.prefix": {
+		LangEnglish:    "FAILED - ",
+		LangIndonesian: "GAGAL - ",
+	},
+	"command.invalid": {
+		LangEnglish:    "Command invalid",
+		LangIndonesian: "Perintah tidak valid",
+	},
+	"command.usage": {
+		LangEnglish:    "Command invalid, usage: `%s`",
+		LangIndonesian: "Perintah tidak valid, penggunaan: `%s`",
+	},
+	"command.admin_only": {
+		LangEnglish:    "FAILED - `%s` is only allowed for RCA admins - Action by %s",
+		LangIndonesian: "GAGAL - `%s` hanya boleh dijalankan admin RCA - Aksi oleh %s",
+	},
+	"rca.invalid_id": {
+		LangEnglish:    "FAILED - Invalid RCA ID (%s) - Action: %s by %s",
+		LangIndonesian: "GAGAL - ID RCA tidak valid (%s) - Aksi: %s oleh %s",
+	},
+
+	// action names used in confirmations and errors
+	"action.set_done": {
+		LangEnglish:    "Set to Done",
+		LangIndonesian: "ditandai Done",
+	},
+	"action.remove": {
+		LangEnglish:    "Removed",
+		LangIndonesian: "dihapus",
+	},
+	"action.restore": {
+		LangEnglish:    "Restore",
+		LangIndonesian: "Pulihkan",
+	},
+	"action.edit": {
+		LangEnglish:    "Edit RCA",
+		LangIndonesian: "Ubah RCA",
+	},
+	"action.edited": {
+		LangEnglish:    "edited",
+		LangIndonesian: "diubah",
+	},
+	"action.set_pma": {
+		LangEnglish:    "Set PMA Ticket",
+		LangIndonesian: "Atur Tiket PMA",
+	},
+	"action.reassign": {
+		LangEnglish:    "Reassign",
+		LangIndonesian: "Ganti Assignee",
+	},
+	"action.snooze": {
+		LangEnglish:    "Snooze",
+		LangIndonesian: "Tunda",
+	},
+	"action.set_due": {
+		LangEnglish:    "Set Due Date",
+		LangIndonesian: "Atur Tenggat",
+	},
+	"action.set_stage": {
+		LangEnglish:    "Set Stage",
+		LangIndonesian: "Atur Tahap",
+	},
+	"action.show": {
+		LangEnglish:    "Show RCA",
+		LangIndonesian: "Tampilkan RCA",
+	},
+	"action.show_history": {
+		LangEnglish:    "Show History",
+		LangIndonesian: "Tampilkan Riwayat",
+	},
+	"action.add_action": {
+		LangEnglish:    "Add Action Item",
+		LangIndonesian: "Tambah Action Item",
+	},
+	"action.done_action": {
+		LangEnglish:    "Done Action Item",
+		LangIndonesian: "Selesaikan Action Item",
+	},
+
+	// webhook, scheduler & footer
+	"webhook.not_set": {
+		LangEnglish:    "Channel Webhook not set, set using command /setslackwebhook [webhook_key] or configure the bot token",
+		LangIndonesian: "Webhook channel belum diatur, atur dengan perintah /setslackwebhook [webhook_key] atau konfigurasikan bot token",
+	},
+	"error.post_failed": {
+		LangEnglish:    "Sorry, the message could not be posted to this channel: %s (invite the bot with `/invite` or set a webhook)",
+		LangIndonesian: "Maaf, pesan tidak bisa dikirim ke channel ini: %s (undang bot dengan `/invite` atau atur webhook)",
+	},
+	"webhook.missing": {
+		LangEnglish:    "Webhook URL Not Present",
+		LangIndonesian: "URL Webhook belum diisi",
+	},
+	"webhook.set": {
+		LangEnglish:    "_Slack Webhook set by %s_",
+		LangIndonesian: "_Slack Webhook diatur oleh %s_",
+	},
+	"scheduler.invalid": {
+		LangEnglish:    "Format scheduler invalid sample: 0 14 * * * (*<https://pkg.go.dev/github.com/robfig/cron/v3|format>*)",
+		LangIndonesian: "Format jadwal tidak valid, contoh: 0 14 * * * (*<https://pkg.go.dev/github.com/robfig/cron/v3|format>*)",
+	},
+	"scheduler.set": {
+		LangEnglish:    "_RCA List scheduler set to %s by %s_",
+		LangIndonesian: "_Jadwal RCA List diatur ke %s oleh %s_",
+	},
+	"scheduler.removed": {
+		LangEnglish:    "_RCA List scheduler removed by %s_",
+		LangIndonesian: "_Jadwal RCA List dihapus oleh %s_",
+	},
+	"footer.set": {
+		LangEnglish:    "_Message RCA - updated to %s by %s_",
+		LangIndonesian: "_Pesan RCA - diubah menjadi %s oleh %s_",
+	},
+
+	// add, status, done & remove
+	"rca.added": {
+		LangEnglish:    "_RCA %s (`%s`) Added by %s_",
+		LangIndonesian: "_RCA %s (`%s`) ditambahkan oleh %s_",
+	},
+	"add.missing": {
+		LangEnglish:    "Missing `%s`, *Sample*: /addrca title=\"DB outage\" assignee=@budi env=staging or (title multi) (desc multi) assignee",
+		LangIndonesian: "`%s` belum diisi, *Contoh*: /addrca title=\"DB outage\" assignee=@budi env=staging atau (judul multi) (deskripsi multi) assignee",
+	},
+	"status.done_removed": {
+		LangEnglish:    "_RCA %s (`%s`) %s by %s_",
+		LangIndonesian: "_RCA %s (`%s`) %s oleh %s_",
+	},
+	"status.open_actions": {
+		LangEnglish:    "FAILED - RCA %s (`%s`) still has %d open action item(s), finish them with /doneaction or add `force`, e.g. `/donerca %s force`",
+		LangIndonesian: "GAGAL - RCA %s (`%s`) masih punya %d action item terbuka, selesaikan dengan /doneaction atau tambahkan `force`, mis. `/donerca %s force`",
+	},
+	"status.moved": {
+		LangEnglish:    "_RCA %s (`%s`) moved to *%s* by %s_",
+		LangIndonesian: "_RCA %s (`%s`) dipindah ke *%s* oleh %s_",
+	},
+	"status.unknown": {
+		LangEnglish:    "Unknown stage `%s`, use one of: %s",
+		LangIndonesian: "Tahap `%s` tidak dikenal, gunakan salah satu: %s",
+	},
+	"status.invalid": {
+		LangEnglish:    "Command invalid, *Sample*: /setstatus issueID stage [force] (%s)",
+		LangIndonesian: "Perintah tidak valid, *Contoh*: /setstatus issueID tahap [force] (%s)",
+	},
+	"status.use_remove": {
+		LangEnglish:    "Use /removerca issueID to remove an RCA",
+		LangIndonesian: "Gunakan /removerca issueID untuk menghapus RCA",
+	},
+	"status.already": {
+		LangEnglish:    "FAILED - RCA %s (`%s`) is already %s",
+		LangIndonesian: "GAGAL - RCA %s (`%s`) sudah %s",
+	},
+	"status.transition": {
+		LangEnglish:    "FAILED - RCA %s (`%s`) cannot move from %s to %s, allowed: %s",
+		LangIndonesian: "GAGAL - RCA %s (`%s`) tidak bisa pindah dari %s ke %s, yang diizinkan: %s",
+	},
+	"doneall.done": {
+		LangEnglish:    "_All RCA Set to Done by %s_",
+		LangIndonesian: "_Semua RCA ditandai Done oleh %s_",
+	},
+	"doneall.skipped": {
+		LangEnglish:    "_Skipped because of open action items: %s_",
+		LangIndonesian: "_Dilewati karena masih ada action item terbuka: %s_",
+	},
+	"restore.invalid": {
+		LangEnglish:    "Command invalid, *Sample*: /restorerca issueID",
+		LangIndonesian: "Perintah tidak valid, *Contoh*: /restorerca issueID",
+	},
+	"restore.not_removed": {
+		LangEnglish:    "FAILED - RCA %s (`%s`) is not removed",
+		LangIndonesian: "GAGAL - RCA %s (`%s`) tidak sedang dihapus",
+	},
+	"restore.done": {
+		LangEnglish:    "_RCA %s (`%s`) Restored to %s by %s_",
+		LangIndonesian: "_RCA %s (`%s`) dipulihkan ke %s oleh %s_",
+	},
+
+	// PMA & due date
+	"pma.set": {
+		LangEnglish:    "_RCA %s (`%s`) PMA Ticket set by %s_",
+		LangIndonesian: "_Tiket PMA RCA %s (`%s`) diatur oleh %s_",
+	},
+	"pma.hint": {
+		LangEnglish:    "PMA Ticket of RCA %s (`%s`) is %s, set it with `/setpma %s PMATicketURL`",
+		LangIndonesian: "Tiket PMA RCA %s (`%s`) %s, atur dengan `/setpma %s PMATicketURL`",
+	},
+	"pma.current": {
+		LangEnglish:    "currently <%s|%s>",
+		LangIndonesian: "saat ini <%s|%s>",
+	},
+	"pma.not_set": {
+		LangEnglish:    "not set yet",
+		LangIndonesian: "belum diatur",
+	},
+	"due.invalid": {
+		LangEnglish:    "Invalid due date `%s`, use YYYY-MM-DD e.g. %s",
+		LangIndonesian: "Tenggat `%s` tidak valid, gunakan YYYY-MM-DD mis. %s",
+	},
+	"due.command_invalid": {
+		LangEnglish:    "Command invalid, *Sample*: /setdue issueID 2026-01-31 (use `none` to clear)",
+		LangIndonesian: "Perintah tidak valid, *Contoh*: /setdue issueID 2026-01-31 (gunakan `none` untuk mengosongkan)",
+	},
+	"due.set": {
+		LangEnglish:    "_RCA %s (`%s`) Due Date set to %s by %s_",
+		LangIndonesian: "_Tenggat RCA %s (`%s`) diatur ke %s oleh %s_",
+	},
+	"due.cleared": {
+		LangEnglish:    "_RCA %s (`%s`) Due Date cleared by %s_",
+		LangIndonesian: "_Tenggat RCA %s (`%s`) dikosongkan oleh %s_",
+	},
+	"due.reminder_title": {
+		LangEnglish:    ":alarm_clock: *Overdue RCA Deck Reminder* (%d)\n\n",
+		LangIndonesian: ":alarm_clock: *Pengingat Deck RCA Lewat Tenggat* (%d)\n\n",
+	},
+	"due.reminder_item": {
+		LangEnglish:    "• %s *%s* (`%s`) - due %s",
+		LangIndonesian: "• %s *%s* (`%s`) - tenggat %s",
+	},
+	"due.reminder_unresolved": {
+		LangEnglish:    " _(no Slack user found for %s, nobody was pinged)_",
+		LangIndonesian: " _(user Slack untuk %s tidak ditemukan, tidak ada yang di-mention)_",
+	},
+	"due.reminder_footer": {
+		LangEnglish:    "_*Please prepare the Deck*_ or update the due date with `/setdue issueID YYYY-MM-DD`",
+		LangIndonesian: "_*Tolong siapkan Deck-nya*_ atau perbarui tenggat dengan `/setdue issueID YYYY-MM-DD`",
+	},
+	"snooze.invalid": {
+		LangEnglish:    "Invalid snooze `%s`, *Sample*: /snoozerca issueID 3 (days), /snoozerca issueID 2026-01-31 or /snoozerca issueID off",
+		LangIndonesian: "Tunda `%s` tidak valid, *Contoh*: /snoozerca issueID 3 (hari), /snoozerca issueID 2026-01-31 atau /snoozerca issueID off",
+	},
+	"snooze.invalid_days": {
+		LangEnglish:    "Invalid snooze `%s`, use 1 to %d days",
+		LangIndonesian: "Tunda `%s` tidak valid, gunakan 1 sampai %d hari",
+	},
+	"snooze.not_active": {
+		LangEnglish:    "RCA %s (`%s`) is %s, only active RCA can be snoozed",
+		LangIndonesian: "RCA %s (`%s`) berstatus %s, hanya RCA aktif yang bisa ditunda",
+	},
+	"snooze.set": {
+		LangEnglish:    "_RCA %s (`%s`) snoozed until %s by %s_",
+		LangIndonesian: "_RCA %s (`%s`) ditunda sampai %s oleh %s_",
+	},
+	"snooze.cleared": {
+		LangEnglish:    "_RCA %s (`%s`) woken up by %s_",
+		LangIndonesian: "_Tunda RCA %s (`%s`) dibatalkan oleh %s_",
+	},
+	"reassign.hint": {
+		LangEnglish:    "RCA %s (`%s`) is assigned to %s, reassign it with `/editrca %s assignee=@someone`",
+		LangIndonesian: "RCA %s (`%s`) ditugaskan ke %s, ganti dengan `/editrca %s assignee=@seseorang`",
+	},
+
+	// action items
+	"action.add_invalid": {
+		LangEnglish:    "Command invalid, use parentheses (for multi space) *Sample*: /addaction issueID (add index on orders table) owner 2026-01-31",
+		LangIndonesian: "Perintah tidak valid, gunakan tanda kurung (untuk teks berspasi) *Contoh*: /addaction issueID (tambah index di tabel orders) owner 2026-01-31",
+	},
+	"action.added": {
+		LangEnglish:    "_Action Item %s `%s` added to RCA %s (`%s`) for %s by %s_",
+		LangIndonesian: "_Action Item %s `%s` ditambahkan ke RCA %s (`%s`) untuk %s oleh %s_",
+	},
+	"action.done_invalid": {
+		LangEnglish:    "Command invalid, *Sample*: /doneaction issueID A1",
+		LangIndonesian: "Perintah tidak valid, *Contoh*: /doneaction issueID A1",
+	},
+	"action.invalid_id": {
+		LangEnglish:    "FAILED - Invalid Action Item ID (%s) for RCA %s (`%s`)",
+		LangIndonesian: "GAGAL - ID Action Item tidak valid (%s) untuk RCA %s (`%s`)",
+	},
+	"action.already_done": {
+		LangEnglish:    "FAILED - Action Item %s (`%s`) is already done",
+		LangIndonesian: "GAGAL - Action Item %s (`%s`) sudah selesai",
+	},
+	"action.done": {
+		LangEnglish:    "_Action Item %s (`%s`) of RCA %s (`%s`) Set to Done by %s_",
+		LangIndonesian: "_Action Item %s (`%s`) dari RCA %s (`%s`) ditandai Done oleh %s_",
+	},
+	"action.still_open": {
+		LangEnglish:    "\n_%d action item(s) still open_",
+		LangIndonesian: "\n_%d action item masih terbuka_",
+	},
+
+	// edit
+	"edit.invalid": {
+		LangEnglish:    "Command invalid, *Sample*: /editrca issueID title=\"DB outage\" assignee=@budi env=staging or /editrca assignee=@budi env=staging set assignee=@andi",
+		LangIndonesian: "Perintah tidak valid, *Contoh*: /editrca issueID title=\"DB outage\" assignee=@budi env=staging atau /editrca assignee=@budi env=staging set assignee=@andi",
+	},
+	"edit.unexpected": {
+		LangEnglish:    "Unexpected `%s` at column %d, use field=value e.g. title=\"DB outage\"",
+		LangIndonesian: "`%s` tidak terduga di kolom %d, gunakan field=nilai mis. title=\"DB outage\"",
+	},
+	"edit.unknown_field": {
+		LangEnglish:    "Unknown field `%s` at column %d, use one of: %s",
+		LangIndonesian: "Field `%s` di kolom %d tidak dikenal, gunakan salah satu: %s",
+	},
+	"edit.nothing": {
+		LangEnglish:    "Nothing to edit, *Sample*: /editrca issueID title=\"DB outage\" assignee=@budi env=staging (fields: %s)",
+		LangIndonesian: "Tidak ada yang diubah, *Contoh*: /editrca issueID title=\"DB outage\" assignee=@budi env=staging (field: %s)",
+	},
+	"edit.unchanged": {
+		LangEnglish:    "Nothing changed, RCA %s (`%s`) already has those values",
+		LangIndonesian: "Tidak ada perubahan, RCA %s (`%s`) sudah berisi nilai tersebut",
+	},
+	"edit.done": {
+		LangEnglish:    "_RCA %s (`%s`) edited by %s_",
+		LangIndonesian: "_RCA %s (`%s`) diubah oleh %s_",
+	},
+	"edit.empty": {
+		LangEnglish:    "_(empty)_",
+		LangIndonesian: "_(kosong)_",
+	},
+
+	// bulk
+	"bulk.invalid_id": {
+		LangEnglish:    "FAILED - Invalid RCA ID (%s)",
+		LangIndonesian: "GAGAL - ID RCA tidak valid (%s)",
+	},
+	"bulk.failed": {
+		LangEnglish:    "FAILED - Nothing changed, %d of %d RCA cannot be %s - Action by %s",
+		LangIndonesian: "GAGAL - Tidak ada yang diubah, %d dari %d RCA tidak bisa %s - Aksi oleh %s",
+	},
+	"bulk.skipped": {
+		LangEnglish:    "• :white_circle: %s (`%s`) - _ok, not changed_",
+		LangIndonesian: "• :white_circle: %s (`%s`) - _ok, tidak diubah_",
+	},
+	"bulk.no_active_match": {
+		LangEnglish:    "No active RCA matches `%s`",
+		LangIndonesian: "Tidak ada RCA aktif yang cocok dengan `%s`",
+	},
+	"bulk.no_match": {
+		LangEnglish:    "No RCA matches `%s`",
+		LangIndonesian: "Tidak ada RCA yang cocok dengan `%s`",
+	},
+	"bulk.status_done": {
+		LangEnglish:    "_%d RCA %s by %s_",
+		LangIndonesian: "_%d RCA %s oleh %s_",
+	},
+	"bulk.edit_done": {
+		LangEnglish:    "_%d RCA edited by %s_",
+		LangIndonesian: "_%d RCA diubah oleh %s_",
+	},
+	"bulk.more": {
+		LangEnglish:    "_… and %d more_",
+		LangIndonesian: "_… dan %d lainnya_",
+	},
+	"bulk.unchanged": {
+		LangEnglish:    " - _unchanged_",
+		LangIndonesian: " - _tidak berubah_",
+	},
+
+	// undo
+	"undo.nothing": {
+		LangEnglish:    "Nothing to undo, only your last change in this channel within %d minutes can be undone",
+		LangIndonesian: "Tidak ada yang bisa dibatalkan, hanya perubahan terakhirmu di channel ini dalam %d menit yang bisa dibatalkan",
+	},
+	"undo.conflict": {
+		LangEnglish:    "FAILED - Cannot undo `%s`, changed since by someone else: %s",
+		LangIndonesian: "GAGAL - `%s` tidak bisa dibatalkan, sudah diubah orang lain: %s",
+	},
+	"undo.changed_meanwhile": {
+		LangEnglish:    "FAILED - Cannot undo `%s`, RCA `%s` changed meanwhile",
+		LangIndonesian: "GAGAL - `%s` tidak bisa dibatalkan, RCA `%s` berubah sementara itu",
+	},
+	"undo.done": {
+		LangEnglish:    "_`%s` undone by %s_",
+		LangIndonesian: "_`%s` dibatalkan oleh %s_",
+	},
+	"undo.restored": {
+		LangEnglish:    "• :leftwards_arrow_with_hook: %s (`%s`) back to %s",
+		LangIndonesian: "• :leftwards_arrow_with_hook: %s (`%s`) kembali ke %s",
+	},
+	"undo.deleted": {
+		LangEnglish:    "• :wastebasket: %s (`%s`) deleted",
+		LangIndonesian: "• :wastebasket: %s (`%s`) dihapus permanen",
+	},
+
+	// argument parsing
+	"args.unexpected_paren": {
+		LangEnglish:    "Unexpected `)` at column %d near `%s`, quote the value or escape it as `\\)`",
+		LangIndonesian: "`)` tidak terduga di kolom %d dekat `%s`, beri tanda kutip atau tulis sebagai `\\)`",
+	},
+	"args.unterminated_quote": {
+		LangEnglish:    "Unterminated %c quote starting at column %d near `%s`",
+		LangIndonesian: "Tanda kutip %c yang dimulai di kolom %d dekat `%s` tidak ditutup",
+	},
+	"args.missing_paren": {
+		LangEnglish:    "Missing `)` for `(` at column %d near `%s`",
+		LangIndonesian: "`)` untuk `(` di kolom %d dekat `%s` tidak ada",
+	},
+	"args.too_many": {
+		LangEnglish:    "Too many arguments, unexpected `%s` at column %d",
+		LangIndonesian: "Argumen terlalu banyak, `%s` tidak terduga di kolom %d",
+	},
+	"args.unknown_flag": {
+		LangEnglish:    "Unknown flag `%s` at column %d, use one of: %s",
+		LangIndonesian: "Flag `%s` di kolom %d tidak dikenal, gunakan salah satu: %s",
+	},
+	"args.duplicate": {
+		LangEnglish:    "`%s` at column %d is already set at column %d",
+		LangIndonesian: "`%s` di kolom %d sudah diisi di kolom %d",
+	},
+	"args.invalid_value": {
+		LangEnglish:    "Invalid `%s` at column %d: %s",
+		LangIndonesian: "`%s` di kolom %d tidak valid: %s",
+	},
+	"args.invalid_page": {
+		LangEnglish:    "Invalid page `%s` at column %d, use a number from 1",
+		LangIndonesian: "Halaman `%s` di kolom %d tidak valid, gunakan angka mulai dari 1",
+	},
+
+	// fields
+	"field.title_empty": {
+		LangEnglish:    "title cannot be empty",
+		LangIndonesian: "judul tidak boleh kosong",
+	},
+	"field.assignee_empty": {
+		LangEnglish:    "assignee cannot be empty",
+		LangIndonesian: "assignee tidak boleh kosong",
+	},
+	"field.invalid_env": {
+		LangEnglish:    "Invalid environment `%s`, use staging or production",
+		LangIndonesian: "Environment `%s` tidak valid, gunakan staging atau production",
+	},
+	"field.invalid_tag": {
+		LangEnglish:    "Invalid tag `%s`, use letters, digits, `-` or `_`",
+		LangIndonesian: "Tag `%s` tidak valid, gunakan huruf, angka, `-` atau `_`",
+	},
+	"severity.invalid": {
+		LangEnglish:    "Invalid severity `%s`, use SEV1 (highest) to SEV4 (lowest)",
+		LangIndonesian: "Severity `%s` tidak valid, gunakan SEV1 (tertinggi) sampai SEV4 (terendah)",
+	},
+
+	// filters
+	"filter.page_not_paged": {
+		LangEnglish:    "`page` only works on paged lists",
+		LangIndonesian: "`page` hanya berlaku di daftar yang berhalaman",
+	},
+	"filter.invalid": {
+		LangEnglish:    "Invalid filter `%s`, *Sample*: sev>=2 assignee=@budi env=staging tag=db older-than=7d",
+		LangIndonesian: "Filter `%s` tidak valid, *Contoh*: sev>=2 assignee=@budi env=staging tag=db older-than=7d",
+	},
+	"filter.empty_assignee": {
+		LangEnglish:    "Empty assignee filter at column %d",
+		LangIndonesian: "Filter assignee kosong di kolom %d",
+	},
+	"filter.one_tag": {
+		LangEnglish:    "Filter one tag at a time, got `%s` at column %d",
+		LangIndonesian: "Filter satu tag saja, didapat `%s` di kolom %d",
+	},
+	"filter.invalid_date": {
+		LangEnglish:    "Invalid `%s` date `%s` at column %d, use YYYY-MM-DD",
+		LangIndonesian: "Tanggal `%s` `%s` di kolom %d tidak valid, gunakan YYYY-MM-DD",
+	},
+	"filter.unknown": {
+		LangEnglish:    "Unknown filter `%s` at column %d, use one of: sev, assignee, env, tag, older-than, since, until",
+		LangIndonesian: "Filter `%s` di kolom %d tidak dikenal, gunakan salah satu: sev, assignee, env, tag, older-than, since, until",
+	},
+	"filter.invalid_age": {
+		LangEnglish:    "Invalid age `%s`, use hours, days or weeks e.g. 12h, 7d, 2w",
+		LangIndonesian: "Umur `%s` tidak valid, gunakan jam, hari atau minggu mis. 12h, 7d, 2w",
+	},
+
+	// lists
+	"list.requested": {
+		LangEnglish:    "_RCA List requested by %s_\n\n",
+		LangIndonesian: "_RCA List diminta oleh %s_\n\n",
+	},
+	"list.title": {
+		LangEnglish:    "*Internal Sharing & RCA List*\n\n",
+		LangIndonesian: "*Daftar Internal Sharing & RCA*\n\n",
+	},
+	"list.title_done": {
+		LangEnglish:    "*Internal Sharing & RCA List - DONE*\n\n",
+		LangIndonesian: "*Daftar Internal Sharing & RCA - SELESAI*\n\n",
+	},
+	"list.page": {
+		LangEnglish:    " (page %d/%d, %d RCA)",
+		LangIndonesian: " (halaman %d/%d, %d RCA)",
+	},
+	"list.title_removed": {
+		LangEnglish:    "*Internal Sharing & RCA List - REMOVED*\n\n",
+		LangIndonesian: "*Daftar Internal Sharing & RCA - DIHAPUS*\n\n",
+	},
+	"list.env_staging": {
+		LangEnglish:    ":arrow_right: `Environment: Staging`\n\n",
+		LangIndonesian: ":arrow_right: `Environment: Staging`\n\n",
+	},
+	"list.env_production": {
+		LangEnglish:    ":arrow_right: `Environment: Production`\n\n",
+		LangIndonesian: ":arrow_right: `Environment: Production`\n\n",
+	},
+	"list.stage": {
+		LangEnglish:    ":small_orange_diamond: *Stage: %s* (%d)",
+		LangIndonesian: ":small_orange_diamond: *Tahap: %s* (%d)",
+	},
+	"list.empty": {
+		LangEnglish:    "\n\n*No RCA Item - Great Job Team* ! :muscle: :muscle: :muscle:",
+		LangIndonesian: "\n\n*Tidak ada RCA - Kerja bagus, Tim* ! :muscle: :muscle: :muscle:",
+	},
+	"list.quote": {
+		LangEnglish: "_`True stability results when presumed order and presumed disorder are balanced. A truly stable system expects the unexpected, is prepared to be disrupted, waits to be transformed`_ - Tom Robbins\n",
+	},
+	"list.footer": {
+		LangEnglish:    "\n`Let's maintain our stability together` :muscle: \n\n_*Please prepare the Deck*_, Team!",
+		LangIndonesian: "\n`Mari jaga stabilitas bersama dengan semangat #gotongroyong dan #makeithappenmakeitbetter` :muscle: \n\n_*Tolong siapkan Deck-nya*_ ya Tim!",
+	},
+	"list.footnote": {
+		LangEnglish:    "\nType `/internalrcahelp` :dart: for more commands",
+		LangIndonesian: "\nKetik `/internalrcahelp` :dart: untuk perintah lainnya",
+	},
+	"item.header": {
+		LangEnglish:    ">\t:%s:  %s*%s* %s\n\t\t\t• `Issue ID:` %s\n\t\t\t• `Description:` %s\n\t\t\t• `Assignee:` %s\n",
+		LangIndonesian: ">\t:%s:  %s*%s* %s\n\t\t\t• `ID Isu:` %s\n\t\t\t• `Deskripsi:` %s\n\t\t\t• `Assignee:` %s\n",
+	},
+	"item.snoozed": {
+		LangEnglish:    "\t\t\t• :zzz: `Snoozed until:` %s\n",
+		LangIndonesian: "\t\t\t• :zzz: `Ditunda sampai:` %s\n",
+	},
+	"item.due": {
+		LangEnglish:    "\t\t\t• `Due:` %s\n",
+		LangIndonesian: "\t\t\t• `Tenggat:` %s\n",
+	},
+	"item.overdue": {
+		LangEnglish:    "\t\t\t• `Due:` %s :alarm_clock: *OVERDUE*\n",
+		LangIndonesian: "\t\t\t• `Tenggat:` %s :alarm_clock: *LEWAT TENGGAT*\n",
+	},
+	"item.actions": {
+		LangEnglish:    "\t\t\t• `Action Items:` %d open / %d total\n",
+		LangIndonesian: "\t\t\t• `Action Item:` %d terbuka / %d total\n",
+	},
+	"item.tags": {
+		LangEnglish:    "\t\t\t• `Tags:` %s\n",
+		LangIndonesian: "\t\t\t• `Tag:` %s\n",
+	},
+	"item.affected": {
+		LangEnglish:    "Affected: %s",
+		LangIndonesian: "Terdampak: %s",
+	},
+	"item.duration": {
+		LangEnglish:    "Duration: %s",
+		LangIndonesian: "Durasi: %s",
+	},
+	"item.business": {
+		LangEnglish:    "Business: %s",
+		LangIndonesian: "Bisnis: %s",
+	},
+	"item.impact": {
+		LangEnglish:    "\t\t\t• `Impact:` %s\n",
+		LangIndonesian: "\t\t\t• `Dampak:` %s\n",
+	},
+	"item.stage": {
+		LangEnglish:    "\t\t\t• `Stage:` %s\n\n",
+		LangIndonesian: "\t\t\t• `Tahap:` %s\n\n",
+	},
+	"item.status": {
+		LangEnglish:    "\t\t\t• `Status:` %s\n\n",
+		LangIndonesian: "\t\t\t• `Status:` %s\n\n",
+	},
+	"myrca.title": {
+		LangEnglish:    "*Internal Sharing & RCA List - Assigned to %s* (%d)\n\n",
+		LangIndonesian: "*Daftar Internal Sharing & RCA - Ditugaskan ke %s* (%d)\n\n",
+	},
+	"myrca.empty": {
+		LangEnglish:    "_No active RCA assigned to you_ :tada:",
+		LangIndonesian: "_Tidak ada RCA aktif yang ditugaskan ke kamu_ :tada:",
+	},
+	"myrca.more": {
+		LangEnglish:    "_%d more not shown, narrow the list with filters e.g. `/myrca sev>=2`_",
+		LangIndonesian: "_%d lainnya tidak ditampilkan, persempit dengan filter mis. `/myrca sev>=2`_",
+	},
+
+	// search
+	"search.invalid": {
+		LangEnglish:    "Command invalid, *Sample*: /searchrca \"payment timeout\" page=2",
+		LangIndonesian: "Perintah tidak valid, *Contoh*: /searchrca \"payment timeout\" page=2",
+	},
+	"search.not_found": {
+		LangEnglish:    "No RCA found for `%s`",
+		LangIndonesian: "Tidak ada RCA untuk `%s`",
+	},
+	"search.page_out_of_range": {
+		LangEnglish:    "Page %d is out of range, `%s` has %d page(s)",
+		LangIndonesian: "Halaman %d di luar jangkauan, `%s` punya %d halaman",
+	},
+	"search.title": {
+		LangEnglish:    "_RCA Search requested by %s_\n\n*Internal Sharing & RCA Search* `%s` - %d result(s), page %d/%d\n\n",
+		LangIndonesian: "_Pencarian RCA diminta oleh %s_\n\n*Pencarian Internal Sharing & RCA* `%s` - %d hasil, halaman %d/%d\n\n",
+	},
+
+	// detail & history
+	"show.invalid": {
+		LangEnglish:    "Command invalid, *Sample*: /showrca issueID",
+		LangIndonesian: "Perintah tidak valid, *Contoh*: /showrca issueID",
+	},
+	"show.title": {
+		LangEnglish:    "_RCA Detail requested by %s_\n\n*Internal Sharing & RCA Detail*\n\n:%s:  %s*%s* (`%s`)",
+		LangIndonesian: "_Detail RCA diminta oleh %s_\n\n*Detail Internal Sharing & RCA*\n\n:%s:  %s*%s* (`%s`)",
+	},
+	"show.history": {
+		LangEnglish:    "*History*",
+		LangIndonesian: "*Riwayat*",
+	},
+	"show.history_more": {
+		LangEnglish:    "_Last %d of %d changes, see `/rcahistory %s` for all_",
+		LangIndonesian: "_%d dari %d perubahan terakhir, lihat `/rcahistory %s` untuk semuanya_",
+	},
+	"show.overdue": {
+		LangEnglish:    " :alarm_clock: *OVERDUE*",
+		LangIndonesian: " :alarm_clock: *LEWAT TENGGAT*",
+	},
+	"show.issue_id": {
+		LangEnglish:    "• `Issue ID:` %s",
+		LangIndonesian: "• `ID Isu:` %s",
+	},
+	"show.status": {
+		LangEnglish:    "• `Status:` %s",
+		LangIndonesian: "• `Status:` %s",
+	},
+	"show.severity": {
+		LangEnglish:    "• `Severity:` %s",
+		LangIndonesian: "• `Severity:` %s",
+	},
+	"show.environment": {
+		LangEnglish:    "• `Environment:` %s",
+		LangIndonesian: "• `Environment:` %s",
+	},
+	"show.assignee": {
+		LangEnglish:    "• `Assignee:` %s",
+		LangIndonesian: "• `Assignee:` %s",
+	},
+	"show.description": {
+		LangEnglish:    "• `Description:` %s",
+		LangIndonesian: "• `Deskripsi:` %s",
+	},
+	"show.pma": {
+		LangEnglish:    "• `PMA:` %s",
+		LangIndonesian: "• `PMA:` %s",
+	},
+	"show.due": {
+		LangEnglish:    "• `Due:` %s",
+		LangIndonesian: "• `Tenggat:` %s",
+	},
+	"show.affected": {
+		LangEnglish:    "• `Affected Users:` %s",
+		LangIndonesian: "• `Pengguna Terdampak:` %s",
+	},
+	"show.duration": {
+		LangEnglish:    "• `Duration:` %s",
+		LangIndonesian: "• `Durasi:` %s",
+	},
+	"show.business_impact": {
+		LangEnglish:    "• `Business Impact:` %s",
+		LangIndonesian: "• `Dampak Bisnis:` %s",
+	},
+	"show.tags": {
+		LangEnglish:    "• `Tags:` %s",
+		LangIndonesian: "• `Tag:` %s",
+	},
+	"show.old_id": {
+		LangEnglish:    "• `Old ID:` %s",
+		LangIndonesian: "• `ID Lama:` %s",
+	},
+	"show.created": {
+		LangEnglish:    "• `Created:` %s",
+		LangIndonesian: "• `Dibuat:` %s",
+	},
+	"show.last_updated": {
+		LangEnglish:    "• `Last Updated:` %s by %s",
+		LangIndonesian: "• `Terakhir Diubah:` %s oleh %s",
+	},
+	"show.snoozed": {
+		LangEnglish:    "• :zzz: `Snoozed until:` %s",
+		LangIndonesian: "• :zzz: `Ditunda sampai:` %s",
+	},
+	"show.removed": {
+		LangEnglish:    "• `Removed:` %s",
+		LangIndonesian: "• `Dihapus:` %s",
+	},
+	"show.actions": {
+		LangEnglish:    "*Action Items* (%d open / %d total)",
+		LangIndonesian: "*Action Item* (%d terbuka / %d total)",
+	},
+	"show.action_done": {
+		LangEnglish:    "• :white_check_mark: `%s` ~%s~ - %s, done by %s %s",
+		LangIndonesian: "• :white_check_mark: `%s` ~%s~ - %s, diselesaikan oleh %s %s",
+	},
+	"show.action_due": {
+		LangEnglish:    ", due %s",
+		LangIndonesian: ", tenggat %s",
+	},
+	"history.invalid": {
+		LangEnglish:    "Command invalid, *Sample*: /rcahistory issueID [page=N]",
+		LangIndonesian: "Perintah tidak valid, *Contoh*: /rcahistory issueID [page=N]",
+	},
+	"history.title": {
+		LangEnglish:    "_RCA History requested by %s_\n\n*Internal Sharing & RCA History*\n\n:memo:  *%s* (`%s`)",
+		LangIndonesian: "_Riwayat RCA diminta oleh %s_\n\n*Riwayat Internal Sharing & RCA*\n\n:memo:  *%s* (`%s`)",
+	},
+	"history.page": {
+		LangEnglish:    " - page %d/%d, %d change(s)",
+		LangIndonesian: " - halaman %d/%d, %d perubahan",
+	},
+	"history.empty": {
+		LangEnglish:    "_No history recorded for this RCA yet_",
+		LangIndonesian: "_Belum ada riwayat untuk RCA ini_",
+	},
+	"history.changed": {
+		LangEnglish:    "%s: `%s` → `%s`",
+		LangIndonesian: "%s: `%s` → `%s`",
+	},
+	"history.event.created": {
+		LangEnglish:    "Created with assignee `%s`, environment `%s`",
+		LangIndonesian: "Dibuat dengan assignee `%s`, environment `%s`",
+	},
+	"history.event.reverted": {
+		LangEnglish:    "Reverted `%s`",
+		LangIndonesian: "Membatalkan `%s`",
+	},
+	"history.event.action_added": {
+		LangEnglish:    "Action %s added: %s (owner %s)",
+		LangIndonesian: "Action %s ditambahkan: %s (pemilik %s)",
+	},
+	"history.event.action_done": {
+		LangEnglish:    "Action %s set to Done",
+		LangIndonesian: "Action %s ditandai Done",
+	},
+	"store.rca_not_found": {
+		LangEnglish:    "RCA not found",
+		LangIndonesian: "RCA tidak ditemukan",
+	},
+	"store.rca_exists": {
+		LangEnglish:    "%s already exists, the issue sequence is behind the stored items",
+		LangIndonesian: "%s sudah ada, nomor urut issue tertinggal dari data yang tersimpan",
+	},
+	"store.unknown_backend": {
+		LangEnglish:    "Unknown store backend: %s",
+		LangIndonesian: "Backend penyimpanan tidak dikenal: %s",
+	},
+	"history.set": {
+		LangEnglish:    "%s set to `%s`",
+		LangIndonesian: "%s diatur ke `%s`",
+	},
+
+	// buttons & menu options, keyed by action_id
+	"button.set_done": {
+		LangEnglish:    "Set Done",
+		LangIndonesian: "Tandai Done",
+	},
+	"button.remove": {
+		LangEnglish:    "Remove",
+		LangIndonesian: "Hapus",
+	},
+	"button.restore": {
+		LangEnglish:    "Restore",
+		LangIndonesian: "Pulihkan",
+	},
+	"button.set_pma": {
+		LangEnglish:    "Set PMA",
+		LangIndonesian: "Atur PMA",
+	},
+	"button.edit": {
+		LangEnglish:    "Edit",
+		LangIndonesian: "Ubah",
+	},
+	"button.reassign": {
+		LangEnglish:    "Reassign",
+		LangIndonesian: "Ganti Assignee",
+	},
+	"button.snooze": {
+		LangEnglish:    "Snooze 1 day",
+		LangIndonesian: "Tunda 1 hari",
+	},
+	"menu.placeholder": {
+		LangEnglish:    "Actions",
+		LangIndonesian: "Aksi",
+	},
+	"button.show": {
+		LangEnglish:    "Show details",
+		LangIndonesian: "Lihat detail",
+	},
+	"button.previous": {
+		LangEnglish:    "Previous",
+		LangIndonesian: "Sebelumnya",
+	},
+	"button.next": {
+		LangEnglish:    "Next",
+		LangIndonesian: "Berikutnya",
+	},
+
+	// modal
+	"modal.add_title": {
+		LangEnglish:    "New RCA",
+		LangIndonesian: "RCA Baru",
+	},
+	"modal.edit_title": {
+		LangEnglish:    "Edit RCA",
+		LangIndonesian: "Ubah RCA",
+	},
+	"modal.submit": {
+		LangEnglish:    "Save",
+		LangIndonesian: "Simpan",
+	},
+	"modal.cancel": {
+		LangEnglish:    "Cancel",
+		LangIndonesian: "Batal",
+	},
+	"modal.pma_title": {
+		LangEnglish:    "Set PMA Ticket",
+		LangIndonesian: "Atur Tiket PMA",
+	},
+	"modal.reassign_title": {
+		LangEnglish:    "Reassign RCA",
+		LangIndonesian: "Ganti Assignee RCA",
+	},
+	"modal.no_bot": {
+		LangEnglish:    "The form needs the bot token (SLACK_BOT_TOKEN), use the command arguments instead",
+		LangIndonesian: "Form membutuhkan bot token (SLACK_BOT_TOKEN), gunakan argumen perintah saja",
+	},
+	"modal.keep_assignee": {
+		LangEnglish:    "Leave empty to keep %s",
+		LangIndonesian: "Kosongkan untuk tetap %s",
+	},
+	"modal.field.title": {
+		LangEnglish:    "Title",
+		LangIndonesian: "Judul",
+	},
+	"modal.field.desc": {
+		LangEnglish:    "Description",
+		LangIndonesian: "Deskripsi",
+	},
+	"modal.field.assignee": {
+		LangEnglish:    "Assignee",
+		LangIndonesian: "Assignee",
+	},
+	"modal.field.pma": {
+		LangEnglish:    "PMA Ticket URL",
+		LangIndonesian: "URL Tiket PMA",
+	},
+	"modal.field.env": {
+		LangEnglish:    "Environment",
+		LangIndonesian: "Environment",
+	},
+	"modal.field.sev": {
+		LangEnglish:    "Severity",
+		LangIndonesian: "Severity",
+	},
+	"modal.field.users": {
+		LangEnglish:    "Affected Users",
+		LangIndonesian: "User Terdampak",
+	},
+	"modal.field.duration": {
+		LangEnglish:    "Duration",
+		LangIndonesian: "Durasi",
+	},
+	"modal.field.impact": {
+		LangEnglish:    "Business Impact",
+		LangIndonesian: "Dampak Bisnis",
+	},
+	"modal.field.due": {
+		LangEnglish:    "Due Date",
+		LangIndonesian: "Tenggat",
+	},
+	"modal.field.tags": {
+		LangEnglish:    "Tags (comma separated)",
+		LangIndonesian: "Tag (pisahkan dengan koma)",
+	},
+
+	// help
+	"help.title": {
+		LangEnglish:    "*Internal RCA BOT Command Help*\n_`issueID` accepts the short ID (`RCA-42` or `42`) or the old long ID, :lock: commands are limited to RCA admins_\n",
+		LangIndonesian: "*Bantuan Perintah Internal RCA BOT*\n_`issueID` menerima ID pendek (`RCA-42` atau `42`) atau ID panjang lama, perintah :lock: hanya untuk admin RCA_\n",
+	},
+	"help.alias": {
+		LangEnglish:    " (alias: `%s`)",
+		LangIndonesian: " (alias: `%s`)",
+	},
+	"help./listrca": {
+		LangIndonesian: "Daftar RCA aktif :memo::memo:, bisa difilter (`sev>=2` menampilkan SEV1 & SEV2, filter berlaku di semua perintah daftar), paling parah dulu dengan tombol Previous/Next",
+	},
+	"help./myrca": {
+		LangIndonesian: "Daftar RCA aktif milikmu dari semua channel, hanya terlihat olehmu",
+	},
+	"help./listdonerca": {
+		LangIndonesian: "Daftar RCA yang sudah Done, terbaru dulu dengan tombol Previous/Next",
+	},
+	"help./searchrca": {
+		LangIndonesian: "Cari judul, deskripsi, assignee dan PMA semua RCA (aktif, done & dihapus), gunakan tanda kutip untuk frasa",
+	},
+	"help./addrca": {
+		LangIndonesian: "Tambah RCA baru, tanpa argumen membuka form. `gunakan tanda kurung atau \"kutip\"` untuk teks berspasi, `-` untuk melewati field opsional. Flag juga bisa: `title= desc= assignee= pma= env= sev= users= duration= impact= due= tags=`. *Contoh*: title=\"DB outage\" assignee=@budi env=staging sev=2",
+	},
+	"help./editrca": {
+		LangIndonesian: "Ubah field RCA (`title= desc= assignee= pma= env= sev= users= duration= impact= due= tags=`), gunakan tanda kutip untuk teks berspasi. Beberapa ID atau selector mengubah banyak RCA sekaligus, semua atau tidak sama sekali. *Contoh*: /editrca RCA-42 title=\"DB outage\" env=staging, /editrca assignee=@budi env=staging set assignee=@andi",
+	},
+	"help./removerca": {
+		LangIndonesian: "Hapus RCA, beberapa ID atau selector (`assignee= env= tag= sev>=2 older-than=`) menghapus semua RCA aktif yang cocok atau tidak sama sekali",
+	},
+	"help./listremovedrca": {
+		LangIndonesian: "Daftar RCA yang dihapus, terbaru dulu dengan tombol Previous/Next",
+	},
+	"help./restorerca": {
+		LangIndonesian: "Pulihkan RCA yang dihapus ke status sebelumnya",
+	},
+	"help./setstatus": {
+		LangIndonesian: "Pindahkan RCA ke suatu tahap (Open, Investigating, Deck Drafting, Review, Presented, Done), Done butuh `force` selama masih ada action item terbuka",
+	},
+	"help./donerca": {
+		LangIndonesian: "Tandai RCA Done, ditolak selama masih ada action item terbuka kecuali `force`. Beberapa ID atau selector bekerja seperti `/removerca`",
+	},
+	"help./doneallrca": {
+		LangIndonesian: "*Done-kan semua* RCA aktif :warning::warning:, `/undorca` membatalkannya",
+	},
+	"help./setpma": {
+		LangIndonesian: "Atur Tiket PMA untuk isu",
+	},
+	"help./setdue": {
+		LangIndonesian: "Atur tenggat deck untuk isu, `none` untuk mengosongkan",
+	},
+	"help./snoozerca": {
+		LangIndonesian: "Keluarkan RCA aktif dari daftar terjadwal dan pengingat tenggat, default satu hari",
+	},
+	"help./addaction": {
+		LangIndonesian: "Tambah action item tindak lanjut ke RCA",
+	},
+	"help./doneaction": {
+		LangIndonesian: "Tandai action item Done",
+	},
+	"help./showrca": {
+		LangIndonesian: "Tampilkan semua field, action item dan riwayat sebuah RCA",
+	},
+	"help./undorca": {
+		LangIndonesian: "Batalkan perubahan RCA terakhirmu di channel ini (termasuk perubahan massal), dalam beberapa menit",
+	},
+	"help./rcahistory": {
+		LangIndonesian: "Tampilkan semua perubahan sebuah RCA, halaman terbaru lebih dulu",
+	},
+	"help./setscheduler": {
+		LangIndonesian: "Atur jadwal RCA List (*<https://pkg.go.dev/github.com/robfig/cron/v3|format>*)",
+	},
+	"help./setslackwebhook": {
+		LangIndonesian: "Atur slack webhook untuk jadwal (*untuk url webhook*, hubungi: <@U75J4HEF9>)",
+	},
+	"help./removescheduler": {
+		LangIndonesian: "Hapus jadwal RCA List",
+	},
+	"help./setfooter": {
+		LangIndonesian: "Atur catatan footer *kustom* yang tampil di bawah RCA List",
+	},
+	"help./setlanguage": {
+		LangIndonesian: "Atur bahasa pesan bot di channel ini (`id` Bahasa Indonesia, `en` English)",
+	},
+	"help./internalrcahelp": {
+		LangIndonesian: "Daftar perintah RCA & Sharing Bot",
+	},
+}
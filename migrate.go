@@ -0,0 +1,444 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	cron "github.com/robfig/cron/v3"
+)
+
+const (
+	ExportVersion = 2
+)
+
+// ExportData is the versioned dump format shared by export, import and
+// migrate. Channel and Scheduler mirror the Firebase tree, History is keyed
+// by channel then issue and was added in version 2.
+type ExportData struct {
+	Version    int                              `json:"version"`
+	ExportedAt time.Time                        `json:"exported_at"`
+	Channel    map[string]Channel               `json:"Channel"`
+	Scheduler  map[string]string                `json:"Scheduler"`
+	History    map[string]map[string][]RCAEvent `json:"History,omitempty"`
+}
+
+func IsCLICommand(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+
+	switch args[0] {
+	case "export", "import", "migrate":
+		return true
+	}
+
+	return false
+}
+
+func RunCLI(args []string) error {
+	switch args[0] {
+	case "export":
+		return runExport(args[1:])
+	case "import":
+		return runImport(args[1:])
+	case "migrate":
+		return runMigrate(args[1:])
+	}
+
+	return errors.New(fmt.Sprintf("Unknown command: %s", args[0]))
+}
+
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	from := fs.String("from", os.Getenv("STORE_BACKEND"), "source backend: firebase, memory, bolt or bolt:path")
+	out := fs.String("out", "", "output file, stdout when empty")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	store, err := OpenStoreSpec(*from)
+	if err != nil {
+		return err
+	}
+
+	data, err := ExportStore(store)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(data); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Exported %d channel(s), %d scheduler(s)\n", len(data.Channel), len(data.Scheduler))
+	return nil
+}
+
+func runImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	to := fs.String("to", os.Getenv("STORE_BACKEND"), "target backend: firebase, memory, bolt or bolt:path")
+	in := fs.String("in", "", "input file produced by export")
+	dryRun := fs.Bool("dry-run", false, "only validate the input file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *in == "" {
+		return errors.New("import: -in is required")
+	}
+
+	raw, err := ioutil.ReadFile(*in)
+	if err != nil {
+		return err
+	}
+
+	var data ExportData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return fmt.Errorf("import: decode %s: %v", *in, err)
+	}
+
+	if err := checkExportData(data); err != nil {
+		return err
+	}
+
+	if *dryRun {
+		fmt.Fprintln(os.Stderr, "Input is valid")
+		return nil
+	}
+
+	store, err := OpenStoreSpec(*to)
+	if err != nil {
+		return err
+	}
+
+	return importAndReport(store, data)
+}
+
+func runMigrate(args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	from := fs.String("from", StoreFirebase, "source backend: firebase, memory, bolt or bolt:path")
+	to := fs.String("to", "", "target backend: firebase, memory, bolt or bolt:path")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *to == "" {
+		return errors.New("migrate: -to is required")
+	}
+
+	if *from == *to {
+		return errors.New("migrate: -from and -to are the same backend")
+	}
+
+	src, err := OpenStoreSpec(*from)
+	if err != nil {
+		return err
+	}
+
+	data, err := ExportStore(src)
+	if err != nil {
+		return err
+	}
+
+	if err := checkExportData(data); err != nil {
+		return err
+	}
+
+	dst, err := OpenStoreSpec(*to)
+	if err != nil {
+		return err
+	}
+
+	return importAndReport(dst, data)
+}
+
+// OpenStoreSpec opens a backend by name, "bolt:path" picks the bolt file.
+func OpenStoreSpec(spec string) (RCAStore, error) {
+	if strings.HasPrefix(spec, StoreBolt+":") {
+		return NewBoltStore(strings.TrimPrefix(spec, StoreBolt+":"))
+	}
+
+	return NewRCAStore(spec)
+}
+
+func ExportStore(store RCAStore) (ExportData, error) {
+	data := ExportData{
+		Version:    ExportVersion,
+		ExportedAt: time.Now().UTC(),
+	}
+
+	var err error
+	data.Channel, err = store.GetAllChannels()
+	if err != nil {
+		return data, err
+	}
+
+	data.Scheduler, err = store.GetAllSchedules()
+	if err != nil {
+		return data, err
+	}
+
+	if data.Channel == nil {
+		data.Channel = map[string]Channel{}
+	}
+
+	data.History = map[string]map[string][]RCAEvent{}
+	for channelID, ch := range data.Channel {
+		for issueID := range ch.Data {
+			events, err := store.GetRCAHistory(channelID, issueID)
+			if err != nil {
+				return data, err
+			}
+
+			if len(events) == 0 {
+				continue
+			}
+
+			if data.History[channelID] == nil {
+				data.History[channelID] = map[string][]RCAEvent{}
+			}
+			data.History[channelID][issueID] = events
+		}
+	}
+
+	if data.Scheduler == nil {
+		data.Scheduler = map[string]string{}
+	}
+
+	return data, nil
+}
+
+// ImportStore writes every item of the dump into the store. Items are keyed
+// by their original IDs, so running it again gives the same result. The issue
+// sequence only moves forward.
+func ImportStore(store RCAStore, data ExportData) error {
+	for _, channelID := range sortedChannelIDs(data.Channel) {
+		ch := data.Channel[channelID]
+
+		if ch.ChannelKey != "" {
+			if err := store.SetChannelKey(channelID, ch.ChannelKey); err != nil {
+				return fmt.Errorf("channel %s: %v", channelID, err)
+			}
+		}
+
+		if ch.Footer != "" {
+			if err := store.SetFooter(channelID, ch.Footer); err != nil {
+				return fmt.Errorf("channel %s: %v", channelID, err)
+			}
+		}
+
+		if ch.Language != "" {
+			if err := store.SetLanguage(channelID, ch.Language); err != nil {
+				return fmt.Errorf("channel %s: %v", channelID, err)
+			}
+		}
+
+		existing, err := store.GetChannel(channelID)
+		if err != nil {
+			return fmt.Errorf("channel %s: %v", channelID, err)
+		}
+
+		//keep the higher sequence so new IDs never land on existing items
+		if ch.Seq > existing.Seq {
+			if err := store.SetIssueSeq(channelID, ch.Seq); err != nil {
+				return fmt.Errorf("channel %s: %v", channelID, err)
+			}
+		}
+
+		for alias, issueID := range ch.Alias {
+			if err := store.SetIssueAlias(channelID, alias, issueID); err != nil {
+				return fmt.Errorf("channel %s alias %s: %v", channelID, alias, err)
+			}
+		}
+
+		for issueID, v := range ch.Data {
+			if err := store.SetRCA(channelID, issueID, v); err != nil {
+				return fmt.Errorf("channel %s issue %s: %v", channelID, issueID, err)
+			}
+		}
+	}
+
+	for channelID, issues := range data.History {
+		for issueID, events := range issues {
+			if err := store.SetRCAHistory(channelID, issueID, events); err != nil {
+				return fmt.Errorf("history %s issue %s: %v", channelID, issueID, err)
+			}
+		}
+	}
+
+	for channelID, interval := range data.Scheduler {
+		if err := store.SetSchedule(channelID, interval); err != nil {
+			return fmt.Errorf("scheduler %s: %v", channelID, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateExportData checks the dump against the RCAData schema and returns
+// every problem found instead of stopping at the first one.
+func ValidateExportData(data ExportData) []error {
+	errs := []error{}
+
+	if data.Version < 1 || data.Version > ExportVersion {
+		errs = append(errs, fmt.Errorf("unsupported version %d, expected 1 to %d", data.Version, ExportVersion))
+	}
+
+	for _, channelID := range sortedChannelIDs(data.Channel) {
+		ch := data.Channel[channelID]
+
+		if ch.Language != "" {
+			if _, err := ParseLanguage(ch.Language); err != nil {
+				errs = append(errs, fmt.Errorf("Channel/%s/language: %v", channelID, err))
+			}
+		}
+
+		for issueID, v := range ch.Data {
+			for _, err := range ValidateRCAData(v) {
+				errs = append(errs, fmt.Errorf("Channel/%s/data/%s: %v", channelID, issueID, err))
+			}
+		}
+
+		for alias, issueID := range ch.Alias {
+			if _, ok := ch.Data[issueID]; !ok {
+				errs = append(errs, fmt.Errorf("Channel/%s/alias/%s: points to missing RCA %s", channelID, alias, issueID))
+			}
+		}
+	}
+
+	for channelID, issues := range data.History {
+		for issueID := range issues {
+			if _, ok := data.Channel[channelID].Data[issueID]; !ok {
+				errs = append(errs, fmt.Errorf("History/%s/%s: no matching RCA", channelID, issueID))
+			}
+		}
+	}
+
+	for channelID, interval := range data.Scheduler {
+		if _, err := cron.ParseStandard(interval); err != nil {
+			errs = append(errs, fmt.Errorf("Scheduler/%s: invalid schedule %q: %v", channelID, interval, err))
+		}
+	}
+
+	return errs
+}
+
+// ValidateExportWarnings lists problems that do not block an import, such as
+// legacy items that were added without an assignee.
+func ValidateExportWarnings(data ExportData) []error {
+	warns := []error{}
+
+	for _, channelID := range sortedChannelIDs(data.Channel) {
+		ch := data.Channel[channelID]
+
+		issueIDs := []string{}
+		for issueID := range ch.Data {
+			issueIDs = append(issueIDs, issueID)
+		}
+		sort.Strings(issueIDs)
+
+		for _, issueID := range issueIDs {
+			if strings.TrimSpace(ch.Data[issueID].Assignee) == "" {
+				warns = append(warns, fmt.Errorf("Channel/%s/data/%s: Assignee is empty", channelID, issueID))
+			}
+		}
+	}
+
+	return warns
+}
+
+func ValidateRCAData(v RCAData) []error {
+	errs := []error{}
+
+	if strings.TrimSpace(v.Title) == "" {
+		errs = append(errs, errors.New("Title is empty"))
+	}
+
+	if !v.Status.IsValid() {
+		errs = append(errs, fmt.Errorf("Status %d is unknown", v.Status))
+	}
+
+	if v.Severity != 0 && SeverityLabel(v.Severity) == "" {
+		errs = append(errs, fmt.Errorf("Severity %d is out of range", v.Severity))
+	}
+
+	if v.DueDate != "" {
+		if _, err := time.Parse(DueDateFormat, v.DueDate); err != nil {
+			errs = append(errs, fmt.Errorf("DueDate %q is not YYYY-MM-DD", v.DueDate))
+		}
+	}
+
+	if v.SnoozedUntil != "" {
+		if _, err := time.Parse(DueDateFormat, v.SnoozedUntil); err != nil {
+			errs = append(errs, fmt.Errorf("SnoozedUntil %q is not YYYY-MM-DD", v.SnoozedUntil))
+		}
+	}
+
+	for _, tag := range v.Tags {
+		if !tagRegex.MatchString(tag) {
+			errs = append(errs, fmt.Errorf("Tag %q is invalid", tag))
+		}
+	}
+
+	return errs
+}
+
+func checkExportData(data ExportData) error {
+	for _, warn := range ValidateExportWarnings(data) {
+		fmt.Fprintln(os.Stderr, "warning:", warn)
+	}
+
+	errs := ValidateExportData(data)
+	if len(errs) == 0 {
+		return nil
+	}
+
+	for _, err := range errs {
+		fmt.Fprintln(os.Stderr, err)
+	}
+
+	return errors.New(fmt.Sprintf("validation failed with %d error(s)", len(errs)))
+}
+
+func importAndReport(store RCAStore, data ExportData) error {
+	if err := ImportStore(store, data); err != nil {
+		return err
+	}
+
+	total := 0
+	for _, ch := range data.Channel {
+		total += len(ch.Data)
+	}
+
+	fmt.Fprintf(os.Stderr, "Imported %d channel(s), %d RCA(s), %d scheduler(s)\n", len(data.Channel), total, len(data.Scheduler))
+	return nil
+}
+
+func sortedChannelIDs(channels map[string]Channel) []string {
+	ids := []string{}
+	for k := range channels {
+		ids = append(ids, k)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,419 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	ViewCallbackAddRCA  = "addrca"
+	ViewCallbackEditRCA = "editrca"
+	ViewCallbackSetPMA  = "setpma"
+)
+
+var (
+	slackMentionRegex = regexp.MustCompile(`^<@([UW][A-Z0-9]+)(\|[^>]*)?>$`)
+)
+
+// SlackView is a modal opened with views.open, every RCA field is one input
+// block whose block_id and action_id are the field name.
+type SlackView struct {
+	Type            string           `json:"type"`
+	CallbackID      string           `json:"callback_id"`
+	PrivateMetadata string           `json:"private_metadata,omitempty"`
+	Title           BlockAccText     `json:"title"`
+	Submit          *BlockAccText    `json:"submit,omitempty"`
+	Close           *BlockAccText    `json:"close,omitempty"`
+	Blocks          []ViewInputBlock `json:"blocks"`
+}
+
+type ViewInputBlock struct {
+	Type     string        `json:"type"`
+	BlockID  string        `json:"block_id"`
+	Label    BlockAccText  `json:"label"`
+	Hint     *BlockAccText `json:"hint,omitempty"`
+	Optional bool          `json:"optional,omitempty"`
+	Element  ViewElement   `json:"element"`
+}
+
+type ViewElement struct {
+	Type          string       `json:"type"`
+	ActionID      string       `json:"action_id"`
+	Multiline     bool         `json:"multiline,omitempty"`
+	InitialValue  string       `json:"initial_value,omitempty"`
+	InitialUser   string       `json:"initial_user,omitempty"`
+	InitialDate   string       `json:"initial_date,omitempty"`
+	InitialOption *ViewOption  `json:"initial_option,omitempty"`
+	Options       []ViewOption `json:"options,omitempty"`
+}
+
+type ViewOption struct {
+	Text  BlockAccText `json:"text"`
+	Value string       `json:"value"`
+}
+
+// ViewMetadata travels in private_metadata, the view_submission payload has
+// no channel of its own.
+type ViewMetadata struct {
+	ChannelID   string `json:"channel_id"`
+	IssueID     string `json:"issue_id,omitempty"`
+	ResponseURL string `json:"response_url,omitempty"`
+}
+
+type ViewPayload struct {
+	ID              string    `json:"id"`
+	CallbackID      string    `json:"callback_id"`
+	PrivateMetadata string    `json:"private_metadata"`
+	State           ViewState `json:"state"`
+}
+
+type ViewState struct {
+	Values map[string]map[string]ViewStateValue `json:"values"`
+}
+
+type ViewStateValue struct {
+	Type           string      `json:"type"`
+	Value          string      `json:"value"`
+	SelectedUser   string      `json:"selected_user"`
+	SelectedDate   string      `json:"selected_date"`
+	SelectedOption *ViewOption `json:"selected_option"`
+}
+
+// ViewSubmissionResponse keeps the modal open and shows the errors under
+// the inputs, keyed by block_id.
+type ViewSubmissionResponse struct {
+	ResponseAction string            `json:"response_action"`
+	Errors         map[string]string `json:"errors"`
+}
+
+// Text is the value as the RCAField setters expect it, a picked user becomes
+// a mention so it renders and matches like a typed one.
+func (v ViewStateValue) Text() string {
+	switch v.Type {
+	case "users_select":
+		if v.SelectedUser != "" {
+			return "<@" + v.SelectedUser + ">"
+		}
+		return ""
+	case "static_select":
+		if v.SelectedOption != nil {
+			return v.SelectedOption.Value
+		}
+		return ""
+	case "datepicker":
+		return v.SelectedDate
+	}
+
+	return strings.TrimSpace(v.Value)
+}
+
+func OpenAddRCAView(ctx CommandContext) error {
+	if slackAPI == nil {
+		return MsgError("modal.no_bot")
+	}
+
+	meta := ViewMetadata{ChannelID: ctx.ChannelID, ResponseURL: ctx.ResponseURL}
+	data := RCAData{Environment: "Production"}
+
+	return slackAPI.OpenView(ctx.TriggerID, RCAView(ctx.Lang, ViewCallbackAddRCA, meta, data, false))
+}
+
+func OpenEditRCAView(ctx CommandContext) error {
+	if slackAPI == nil {
+		return MsgError("modal.no_bot")
+	}
+
+	meta, v, err := viewRCA(ctx, Msg("action.edit"))
+	if err != nil {
+		return err
+	}
+
+	return slackAPI.OpenView(ctx.TriggerID, RCAView(ctx.Lang, ViewCallbackEditRCA, meta, v, true))
+}
+
+// OpenReassignRCAView is the edit form with only the assignee picker, the
+// submission is an edit like any other.
+func OpenReassignRCAView(ctx CommandContext) error {
+	if slackAPI == nil {
+		return MsgError("modal.no_bot")
+	}
+
+	meta, v, err := viewRCA(ctx, Msg("action.reassign"))
+	if err != nil {
+		return err
+	}
+
+	f, _ := FindRCAField("assignee")
+	block := rcaViewBlock(ctx.Lang, f, v, false)
+
+	view := RCAView(ctx.Lang, ViewCallbackEditRCA, meta, v, true)
+	view.Title = plainText(T(ctx.Lang, "modal.reassign_title"))
+	view.Blocks = []ViewInputBlock{block}
+
+	return slackAPI.OpenView(ctx.TriggerID, view)
+}
+
+// OpenSetPMAView asks for the PMA ticket link only, the submission goes
+// through /setpma.
+func OpenSetPMAView(ctx CommandContext) error {
+	if slackAPI == nil {
+		return MsgError("modal.no_bot")
+	}
+
+	meta, v, err := viewRCA(ctx, Msg("action.set_pma"))
+	if err != nil {
+		return err
+	}
+
+	f, _ := FindRCAField("pma")
+	block := rcaViewBlock(ctx.Lang, f, v, true)
+	block.Optional = false
+
+	view := RCAView(ctx.Lang, ViewCallbackSetPMA, meta, v, true)
+	view.Title = plainText(T(ctx.Lang, "modal.pma_title"))
+	view.Blocks = []ViewInputBlock{block}
+
+	return slackAPI.OpenView(ctx.TriggerID, view)
+}
+
+func viewRCA(ctx CommandContext, action LocalizedText) (ViewMetadata, RCAData, error) {
+	issueID, v, err := FindRCA(ctx.ChannelID, ctx.Text)
+	if err != nil {
+		return ViewMetadata{}, v, err
+	}
+
+	if v.Title == "" {
+		return ViewMetadata{}, v, MsgError("rca.invalid_id", ctx.Text, action, ctx.UserName)
+	}
+
+	return ViewMetadata{ChannelID: ctx.ChannelID, IssueID: issueID, ResponseURL: ctx.ResponseURL}, v, nil
+}
+
+func RCAView(lang Language, callbackID string, meta ViewMetadata, v RCAData, editing bool) SlackView {
+	b, _ := json.Marshal(meta)
+
+	title := T(lang, "modal.add_title")
+	if editing {
+		title = T(lang, "modal.edit_title")
+	}
+
+	view := SlackView{
+		Type:            "modal",
+		CallbackID:      callbackID,
+		PrivateMetadata: string(b),
+		Title:           plainText(title),
+		Submit:          plainTextRef(T(lang, "modal.submit")),
+		Close:           plainTextRef(T(lang, "modal.cancel")),
+	}
+
+	for _, f := range RCAFields {
+		view.Blocks = append(view.Blocks, rcaViewBlock(lang, f, v, editing))
+	}
+
+	return view
+}
+
+func rcaViewBlock(lang Language, f RCAField, v RCAData, editing bool) ViewInputBlock {
+	block := ViewInputBlock{
+		Type:     "input",
+		BlockID:  f.Name,
+		Label:    plainText(T(lang, "modal.field."+f.Name)),
+		Optional: f.Name != "title" && f.Name != "assignee" && f.Name != "env",
+		Element: ViewElement{
+			Type:         "plain_text_input",
+			ActionID:     f.Name,
+			InitialValue: f.Get(v),
+		},
+	}
+
+	switch f.Name {
+	case "desc", "impact":
+		block.Element.Multiline = true
+
+	case "assignee":
+		block.Element = ViewElement{Type: "users_select", ActionID: f.Name}
+		if m := slackMentionRegex.FindStringSubmatch(v.Assignee); m != nil {
+			block.Element.InitialUser = m[1]
+		} else if editing {
+			//typed assignees have no Slack user to preselect
+			block.Optional = true
+			block.Hint = plainTextRef(T(lang, "modal.keep_assignee", v.Assignee))
+		}
+
+	case "pma":
+		//Slack rejects the view when the initial value is not a URL
+		if v.PMA == "" || strings.HasPrefix(v.PMA, "http://") || strings.HasPrefix(v.PMA, "https://") {
+			block.Element.Type = "url_text_input"
+		}
+
+	case "env":
+		block.Element = ViewElement{Type: "static_select", ActionID: f.Name}
+		for _, env := range []string{"Production", "Staging"} {
+			opt := ViewOption{Text: plainText(env), Value: env}
+			block.Element.Options = append(block.Element.Options, opt)
+			if env == v.Environment {
+				block.Element.InitialOption = &opt
+			}
+		}
+
+	case "sev":
+		block.Element = ViewElement{Type: "static_select", ActionID: f.Name}
+		for sev := MinSeverity; sev <= MaxSeverity; sev++ {
+			opt := ViewOption{Text: plainText(SeverityLabel(sev)), Value: strconv.Itoa(sev)}
+			block.Element.Options = append(block.Element.Options, opt)
+			if sev == v.Severity {
+				block.Element.InitialOption = &opt
+			}
+		}
+
+	case "due":
+		block.Element = ViewElement{Type: "datepicker", ActionID: f.Name, InitialDate: v.DueDate}
+	}
+
+	return block
+}
+
+// ReadRCAView returns the submitted text keyed by field name, fields the
+// view did not show are left out.
+func ReadRCAView(state ViewState) map[string]string {
+	values := map[string]string{}
+	for _, f := range RCAFields {
+		if block, ok := state.Values[f.Name]; ok {
+			values[f.Name] = block[f.Name].Text()
+		}
+	}
+
+	return values
+}
+
+// ParseRCAView builds a new RCA from the add form, errors are keyed by the
+// block they belong to.
+func ParseRCAView(lang Language, values map[string]string) (RCAData, map[string]string) {
+	data := RCAData{Status: StatusOpen}
+	errs := map[string]string{}
+
+	for _, f := range RCAFields {
+		if err := f.Set(&data, values[f.Name]); err != nil {
+			errs[f.Name] = LocalizeError(lang, err)
+		}
+	}
+
+	return data, errs
+}
+
+// ParseRCAViewEdits turns the edit form into /editrca edits, an empty
+// assignee keeps the current one.
+func ParseRCAViewEdits(lang Language, values map[string]string) ([]RCAFieldEdit, map[string]string) {
+	edits := []RCAFieldEdit{}
+	errs := map[string]string{}
+
+	for _, f := range RCAFields {
+		value, ok := values[f.Name]
+		if !ok || (f.Name == "assignee" && value == "") {
+			continue
+		}
+
+		var probe RCAData
+		if err := f.Set(&probe, value); err != nil {
+			errs[f.Name] = LocalizeError(lang, err)
+			continue
+		}
+
+		edits = append(edits, RCAFieldEdit{Field: f, Value: value})
+	}
+
+	return edits, errs
+}
+
+// HandleViewSubmission runs the submitted form through /addrca, /editrca or
+// /setpma, so permissions, history and undo work as for the typed commands. An empty
+// reply closes the modal.
+func HandleViewSubmission(w http.ResponseWriter, payload InteractivePayload) {
+	var meta ViewMetadata
+	if err := json.Unmarshal([]byte(payload.View.PrivateMetadata), &meta); err != nil || meta.ChannelID == "" {
+		Printf(nil, "[Custom Binary] View metadata decode error: %+v, view: %+v", err, payload.View)
+		return
+	}
+
+	lang := ChannelLanguage(meta.ChannelID)
+	values := ReadRCAView(payload.View.State)
+
+	var (
+		name    string
+		errs    map[string]string
+		handler func(ctx CommandContext) (CommandResult, error)
+	)
+
+	switch payload.View.CallbackID {
+	case ViewCallbackAddRCA:
+		var data RCAData
+		data, errs = ParseRCAView(lang, values)
+		name = "/addrca"
+		handler = func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(CreateRCA(ctx.UserName, ctx.ChannelID, data))
+		}
+	case ViewCallbackEditRCA:
+		var edits []RCAFieldEdit
+		edits, errs = ParseRCAViewEdits(lang, values)
+		name = "/editrca"
+		handler = func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(editSingleRCA(ctx.UserName, ctx.ChannelID, meta.IssueID, edits))
+		}
+	case ViewCallbackSetPMA:
+		name = "/setpma"
+		handler = func(ctx CommandContext) (CommandResult, error) {
+			return messageResult(SetPMA(ctx.UserName, ctx.ChannelID, meta.IssueID+" "+values["pma"]))
+		}
+	default:
+		Printf(nil, "[Custom Binary] Unknown view callback: %s", payload.View.CallbackID)
+		return
+	}
+
+	if len(errs) > 0 {
+		WriteResponse(w, ViewSubmissionResponse{ResponseAction: "errors", Errors: errs})
+		return
+	}
+
+	base, _ := FindCommand(name)
+	cmd := *base
+	cmd.MinArgs = 0
+	cmd.Handler = handler
+
+	result, err := ExecuteCommand(&cmd, CommandContext{
+		UserName:    payload.User.Username,
+		UserID:      payload.User.ID,
+		ChannelID:   meta.ChannelID,
+		Command:     name,
+		ResponseURL: meta.ResponseURL,
+	})
+	if err != nil {
+		//the reassign and PMA forms only have their own block
+		blockID := "title"
+		for _, name := range []string{"title", "assignee", "pma"} {
+			if _, ok := values[name]; ok {
+				blockID = name
+				break
+			}
+		}
+
+		WriteResponse(w, ViewSubmissionResponse{ResponseAction: "errors", Errors: map[string]string{blockID: err.Error()}})
+		return
+	}
+
+	message := SlackMsgStructure{Blocks: GetSlackTextBlocks(strings.Split(result.Message, "\n"))}
+	if err := SendCommandResult(result, message); err != nil {
+		Println(nil, "VIEW SUBMISSION POST ERROR, err: ", err)
+	}
+}
+
+func plainText(text string) BlockAccText {
+	return BlockAccText{Type: "plain_text", Text: text, Emoji: true}
+}
+
+func plainTextRef(text string) *BlockAccText {
+	t := plainText(text)
+	return &t
+}
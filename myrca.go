@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type channelRCA struct {
+	ChannelID string
+	IssueID   string
+	Data      RCAData
+}
+
+// MyRCA lists the active items assigned to the caller in every channel the
+// bot stores, the list filters apply on top.
+func MyRCA(lang Language, uname, userID, text string) (SlackMsgStructure, error) {
+	f, err := ParseRCAFilter(text)
+	if err != nil {
+		return SlackMsgStructure{}, err
+	}
+
+	f.Assignees = []string{uname}
+	if userID != "" {
+		f.Assignees = append(f.Assignees, userID)
+	}
+
+	//items are often assigned by display name, which the slash command lacks
+	if slackAPI != nil && userID != "" {
+		user, err := slackAPI.UserInfo(userID)
+		if err != nil {
+			Println(nil, "MYRCA USERS INFO ERROR, err: ", err)
+		} else {
+			f.Assignees = append(f.Assignees, user.Name, user.Profile.DisplayName)
+		}
+	}
+
+	channels, err := GetAllRCAData()
+	if err != nil {
+		return SlackMsgStructure{}, err
+	}
+
+	return ConstructMyRCAString(lang, channels, f, uname), nil
+}
+
+func ConstructMyRCAString(lang Language, channels map[string]Channel, f RCAFilter, uname string) SlackMsgStructure {
+	items := []channelRCA{}
+
+	for channelID, ch := range channels {
+		for issueID, is := range ch.Data {
+			if is.Status.IsActive() && f.Match(issueID, is) {
+				items = append(items, channelRCA{ChannelID: channelID, IssueID: issueID, Data: is})
+			}
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.ChannelID != b.ChannelID {
+			return a.ChannelID < b.ChannelID
+		}
+
+		if ra, rb := SeverityRank(a.Data.Severity), SeverityRank(b.Data.Severity); ra != rb {
+			return ra < rb
+		}
+
+		return RCACreatedAt(a.IssueID, a.Data) > RCACreatedAt(b.IssueID, b.Data)
+	})
+
+	slackMsg := SlackMsgStructure{}
+	title := T(lang, "list.requested", uname) + T(lang, "myrca.title", uname, len(items))
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(title))
+
+	if len(items) == 0 {
+		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackDividerBlock())
+		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(T(lang, "myrca.empty")))
+		return AppendFootNotes(lang, slackMsg)
+	}
+
+	//room for the "more" line and the footnotes
+	budget := MaxSlackBlocks - 3
+
+	shown := 0
+	lastChannel := ""
+	for _, item := range items {
+		need := 1
+		if item.ChannelID != lastChannel {
+			need += 2 //divider and channel header
+		}
+
+		if len(slackMsg.Blocks)+need > budget {
+			break
+		}
+
+		if item.ChannelID != lastChannel {
+			lastChannel = item.ChannelID
+			slackMsg.Blocks = append(slackMsg.Blocks, GetSlackDividerBlock())
+			slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(fmt.Sprintf(":arrow_right: <#%s>\n\n", item.ChannelID)))
+		}
+
+		text := strings.TrimSuffix(GetRCAItemText(lang, item.Data.Status.Emoji(), item.IssueID, item.Data), "\n")
+		text += T(lang, "item.stage", item.Data.Status)
+		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(text))
+		shown++
+	}
+
+	if len(items) > shown {
+		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(T(lang, "myrca.more", len(items)-shown)))
+	}
+
+	return AppendFootNotes(lang, slackMsg)
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	SearchPageSize = 10
+)
+
+// field weights for /searchrca ranking, a hit in the title counts the most
+const (
+	searchWeightTitle       = 5
+	searchWeightAssignee    = 4
+	searchWeightDescription = 2
+	searchWeightPMA         = 1
+
+	searchBonusWord   = 2 //term is a whole word, not only a substring
+	searchBonusPhrase = 5 //whole query appears in the title
+)
+
+// SearchQuery is the parsed /searchrca text, quoted text is one term.
+type SearchQuery struct {
+	Terms []string
+	Page  int
+
+	words []*regexp.Regexp //whole word match of each term
+}
+
+type SearchResult struct {
+	IssueID string
+	Data    RCAData
+	Score   int
+}
+
+func ParseSearchQuery(text string) (SearchQuery, error) {
+	q := SearchQuery{Page: 1}
+
+	tokens, err := TokenizeArgs(text)
+	if err != nil {
+		return q, err
+	}
+
+	for _, t := range tokens {
+		if t.Key == "page" {
+			page, err := strconv.Atoi(t.Value)
+			if err != nil || page < 1 {
+				return q, MsgError("args.invalid_page", t.Value, t.Col)
+			}
+			q.Page = page
+			continue
+		}
+
+		term := t.Value
+		if t.Key != "" {
+			term = t.Key + "=" + t.Value
+		}
+
+		if term = strings.ToLower(strings.TrimSpace(term)); term != "" {
+			q.Terms = append(q.Terms, term)
+			q.words = append(q.words, regexp.MustCompile(`(^|\W)`+regexp.QuoteMeta(term)+`($|\W)`))
+		}
+	}
+
+	if len(q.Terms) == 0 {
+		return q, MsgError("search.invalid")
+	}
+
+	return q, nil
+}
+
+func (q SearchQuery) String() string {
+	quoted := []string{}
+	for _, term := range q.Terms {
+		//quoted so the Previous/Next buttons parse it back to the same terms
+		if strings.ContainsAny(term, " \t\"'()\\") {
+			term = "\"" + strings.Replace(term, "\"", "\\\"", -1) + "\""
+		}
+		quoted = append(quoted, term)
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+// ScoreRCA ranks one item against the query, every term has to match at
+// least one field otherwise the score is 0.
+func ScoreRCA(is RCAData, q SearchQuery) int {
+	fields := []struct {
+		text   string
+		weight int
+	}{
+		{strings.ToLower(is.Title), searchWeightTitle},
+		{strings.ToLower(is.Assignee), searchWeightAssignee},
+		{strings.ToLower(is.Description), searchWeightDescription},
+		{strings.ToLower(is.PMA), searchWeightPMA},
+	}
+
+	score := 0
+	for i, term := range q.Terms {
+		termScore := 0
+
+		for _, f := range fields {
+			if !strings.Contains(f.text, term) {
+				continue
+			}
+
+			termScore += f.weight
+			if i < len(q.words) && q.words[i].MatchString(f.text) {
+				termScore += searchBonusWord
+			}
+		}
+
+		if termScore == 0 {
+			return 0
+		}
+		score += termScore
+	}
+
+	if len(q.Terms) > 1 && strings.Contains(fields[0].text, strings.Join(q.Terms, " ")) {
+		score += searchBonusPhrase
+	}
+
+	return score
+}
+
+// SearchRCAData returns every matching item of the channel in any status,
+// best match first and newest first on ties.
+func SearchRCAData(v Channel, q SearchQuery) []SearchResult {
+	results := []SearchResult{}
+
+	for issueID, is := range v.Data {
+		if score := ScoreRCA(is, q); score > 0 {
+			results = append(results, SearchResult{IssueID: issueID, Data: is, Score: score})
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+
+		ci, cj := RCACreatedAt(results[i].IssueID, results[i].Data), RCACreatedAt(results[j].IssueID, results[j].Data)
+		if ci != cj {
+			return ci > cj
+		}
+		return results[i].IssueID < results[j].IssueID
+	})
+
+	return results
+}
+
+func SearchRCA(uname, text string, channelData Channel) (SlackMsgStructure, error) {
+	q, err := ParseSearchQuery(text)
+	if err != nil {
+		return SlackMsgStructure{}, err
+	}
+
+	return ConstructSearchResult(channelData.Lang(), SearchRCAData(channelData, q), q, uname)
+}
+
+func ConstructSearchResult(lang Language, results []SearchResult, q SearchQuery, uname string) (SlackMsgStructure, error) {
+	slackMsg := SlackMsgStructure{}
+
+	if len(results) == 0 {
+		return slackMsg, MsgError("search.not_found", q)
+	}
+
+	pages := int(math.Ceil(float64(len(results)) / SearchPageSize))
+	if q.Page > pages {
+		return slackMsg, MsgError("search.page_out_of_range", q.Page, q, pages)
+	}
+
+	start := (q.Page - 1) * SearchPageSize
+	end := start + SearchPageSize
+	if end > len(results) {
+		end = len(results)
+	}
+
+	title := T(lang, "search.title", uname, q, len(results), q.Page, pages)
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(title))
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackDividerBlock())
+
+	for _, r := range results[start:end] {
+		text := strings.TrimSuffix(GetRCAItemText(lang, r.Data.Status.Emoji(), r.IssueID, r.Data), "\n")
+		text += T(lang, "item.status", r.Data.Status)
+
+		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(text, GetRCAItemMenu(lang, r.IssueID, r.Data)))
+	}
+
+	if pages > 1 {
+		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackDividerBlock())
+		slackMsg.Blocks = append(slackMsg.Blocks, GetPageNavBlock(lang, "/searchrca", q.Page, pages, q.String()))
+	}
+
+	return AppendFootNotes(lang, slackMsg), nil
+}
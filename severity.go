@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	MinSeverity = 1
+	MaxSeverity = 4
+)
+
+// ParseSeverity accepts SEV1..SEV4 in any case or the bare number, empty
+// text and "-" mean the severity is not set (0).
+func ParseSeverity(text string) (int, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || text == "-" {
+		return 0, nil
+	}
+
+	sev, err := strconv.Atoi(strings.TrimPrefix(strings.ToLower(text), "sev"))
+	if err != nil || sev < MinSeverity || sev > MaxSeverity {
+		return 0, MsgError("severity.invalid", text)
+	}
+
+	return sev, nil
+}
+
+func SeverityLabel(sev int) string {
+	if sev < MinSeverity || sev > MaxSeverity {
+		return ""
+	}
+
+	return fmt.Sprintf("SEV%d", sev)
+}
+
+// SeverityRank orders SEV1 first and items without severity last.
+func SeverityRank(sev int) int {
+	if sev < MinSeverity || sev > MaxSeverity {
+		return MaxSeverity + 1
+	}
+
+	return sev
+}
+
+func SeverityEmoji(sev int) string {
+	switch sev {
+	case 1:
+		return ":red_circle:"
+	case 2:
+		return ":large_orange_circle:"
+	case 3:
+		return ":large_yellow_circle:"
+	case 4:
+		return ":white_circle:"
+	}
+
+	return ""
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	MaxShowRCAEvents = 10 //the full trail is in /rcahistory
+)
+
+func ShowRCA(uname, channelID, text string) (SlackMsgStructure, error) {
+	slackMsg := SlackMsgStructure{}
+	desc := strings.Fields(text)
+
+	if len(desc) < 1 {
+		return slackMsg, MsgError("show.invalid")
+	}
+
+	issueID, v, err := FindRCA(channelID, desc[0])
+	if err != nil {
+		return slackMsg, err
+	}
+
+	if v.Title == "" {
+		return slackMsg, MsgError("rca.invalid_id", desc[0], Msg("action.show"), uname)
+	}
+
+	events, err := Store.GetRCAHistory(channelID, issueID)
+	if err != nil {
+		return slackMsg, err
+	}
+
+	return ConstructRCADetailString(ChannelLanguage(channelID), issueID, v, events, uname), nil
+}
+
+func ConstructRCADetailString(lang Language, issueID string, v RCAData, events []RCAEvent, uname string) SlackMsgStructure {
+	slackMsg := SlackMsgStructure{}
+
+	sev := ""
+	if v.Severity != 0 {
+		sev = fmt.Sprintf("%s `%s` ", SeverityEmoji(v.Severity), SeverityLabel(v.Severity))
+	}
+
+	title := T(lang, "show.title", uname, v.Status.Emoji(), sev, v.Title, v.DisplayID(issueID))
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(title))
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackDividerBlock())
+	//long descriptions and trails are split so no section passes the text limit
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackTextBlocks(strings.Split(GetRCADetailText(lang, issueID, v, events), "\n"))...)
+
+	if len(v.Actions) > 0 {
+		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackDividerBlock())
+		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackTextBlocks(strings.Split(GetRCAActionItemsText(lang, v), "\n"))...)
+	}
+
+	slackMsg.Blocks = append(slackMsg.Blocks, GetSlackDividerBlock())
+	if len(events) == 0 {
+		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackMessageStructure(T(lang, "show.history")+"\n"+T(lang, "history.empty")))
+	} else {
+		lines := []string{T(lang, "show.history")}
+		shown := events
+		if len(shown) > MaxShowRCAEvents {
+			shown = shown[len(shown)-MaxShowRCAEvents:]
+			lines = append(lines, T(lang, "show.history_more", MaxShowRCAEvents, len(events), v.DisplayID(issueID)))
+		}
+
+		for _, ev := range shown {
+			lines = append(lines, FormatRCAEvent(lang, ev))
+		}
+		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackTextBlocks(lines)...)
+	}
+
+	if buttons := GetRCADetailButtons(lang, issueID, v); len(buttons) > 0 {
+		slackMsg.Blocks = append(slackMsg.Blocks, GetSlackActionsBlock(buttons...))
+	}
+
+	return AppendFootNotes(lang, slackMsg)
+}
+
+func GetRCADetailText(lang Language, issueID string, v RCAData, events []RCAEvent) string {
+	orNone := func(text string) string {
+		if text == "" {
+			return "-"
+		}
+		return text
+	}
+
+	pma := "-"
+	if v.PMA != "" {
+		pma = fmt.Sprintf("<%s|%s>", v.PMA, v.PMA)
+	}
+
+	due := orNone(v.DueDate)
+	if IsOverdue(v, time.Now()) {
+		due += T(lang, "show.overdue")
+	}
+
+	lines := []string{
+		T(lang, "show.issue_id", v.DisplayID(issueID)),
+		T(lang, "show.status", v.Status),
+		T(lang, "show.severity", orNone(SeverityLabel(v.Severity))),
+		T(lang, "show.environment", orNone(v.Environment)),
+		T(lang, "show.assignee", v.Assignee),
+		T(lang, "show.description", orNone(v.Description)),
+		T(lang, "show.pma", pma),
+		T(lang, "show.due", due),
+		T(lang, "show.affected", orNone(v.AffectedUsers)),
+		T(lang, "show.duration", orNone(v.Duration)),
+		T(lang, "show.business_impact", orNone(v.BusinessImpact)),
+		T(lang, "show.tags", orNone(FormatTags(v.Tags))),
+	}
+
+	if v.ShortID != "" && v.ShortID != issueID {
+		lines = append(lines, T(lang, "show.old_id", issueID))
+	}
+
+	if created := RCACreatedAt(issueID, v); created != 0 {
+		lines = append(lines, T(lang, "show.created", time.Unix(0, created).Format(HistoryTimeFormat)))
+	}
+
+	if len(events) > 0 {
+		last := events[len(events)-1]
+		lines = append(lines, T(lang, "show.last_updated", time.Unix(last.Time, 0).Format(HistoryTimeFormat), last.User))
+	}
+
+	if IsSnoozed(v, time.Now()) {
+		lines = append(lines, T(lang, "show.snoozed", v.SnoozedUntil))
+	}
+
+	if v.Status == StatusRemoved && v.RemovedAt != 0 {
+		lines = append(lines, T(lang, "show.removed", time.Unix(v.RemovedAt, 0).Format(HistoryTimeFormat)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func GetRCAActionItemsText(lang Language, v RCAData) string {
+	lines := []string{T(lang, "show.actions", v.OpenActionCount(), len(v.Actions))}
+
+	for _, actionID := range SortedActionIDs(v.Actions) {
+		a := v.Actions[actionID]
+
+		line := fmt.Sprintf("• :white_large_square: `%s` %s - %s", actionID, a.Text, a.Owner)
+		if a.Done {
+			line = T(lang, "show.action_done", actionID, a.Text, a.Owner, a.DoneBy, time.Unix(a.DoneAt, 0).Format(HistoryTimeFormat))
+		} else if a.DueDate != "" {
+			line += T(lang, "show.action_due", a.DueDate)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// GetRCADetailButtons returns the buttons that make sense for the item's
+// current status.
+func GetRCADetailButtons(lang Language, issueID string, v RCAData) []*BlockAcc {
+	buttons := []*BlockAcc{}
+
+	if v.Status == StatusRemoved {
+		return append(buttons, GetSlackAccessory(lang, ActionRestore, issueID))
+	}
+
+	if v.Status.CanTransitionTo(StatusDone) {
+		done := GetSlackAccessory(lang, ActionSetDone, issueID)
+		done.Style = "primary"
+		buttons = append(buttons, done)
+	}
+
+	if v.Status.CanTransitionTo(StatusRemoved) {
+		remove := GetSlackAccessory(lang, ActionRemove, issueID)
+		remove.Style = "danger"
+		buttons = append(buttons, remove)
+	}
+
+	if slackAPI != nil {
+		buttons = append(buttons, GetSlackAccessory(lang, ActionEdit, issueID))
+	}
+
+	buttons = append(buttons, GetSlackAccessory(lang, ActionReassign, issueID))
+
+	if v.Status.IsActive() {
+		buttons = append(buttons, GetSlackAccessory(lang, ActionSnooze, issueID))
+	}
+
+	return append(buttons, GetSlackAccessory(lang, ActionSetPMA, issueID))
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSigningSecret = "8f742231b10e8888abcd99yyyzzz85a5"
+
+var testSignatureNow = time.Unix(1531420618, 0)
+
+func signedHeader(secret string, ts time.Time, body string) http.Header {
+	timestamp := strconv.FormatInt(ts.Unix(), 10)
+
+	header := http.Header{}
+	header.Set("X-Slack-Request-Timestamp", timestamp)
+	header.Set("X-Slack-Signature", SlackSignature(secret, timestamp, []byte(body)))
+	return header
+}
+
+func TestVerifySlackSignature(t *testing.T) {
+	body := "token=xyz&command=%2Flistrca&text="
+
+	tests := []struct {
+		name    string
+		header  func() http.Header
+		body    string
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			header: func() http.Header { return signedHeader(testSigningSecret, testSignatureNow, body) },
+			body:   body,
+		},
+		{
+			name:    "forged with another secret",
+			header:  func() http.Header { return signedHeader("not-the-secret", testSignatureNow, body) },
+			body:    body,
+			wantErr: true,
+		},
+		{
+			name:    "body changed after signing",
+			header:  func() http.Header { return signedHeader(testSigningSecret, testSignatureNow, body) },
+			body:    body + "&user_name=admin",
+			wantErr: true,
+		},
+		{
+			name: "wrong version prefix",
+			header: func() http.Header {
+				h := signedHeader(testSigningSecret, testSignatureNow, body)
+				h.Set("X-Slack-Signature", "v1"+strings.TrimPrefix(h.Get("X-Slack-Signature"), "v0"))
+				return h
+			},
+			body:    body,
+			wantErr: true,
+		},
+		{
+			name: "timestamp over 5 minutes old",
+			header: func() http.Header {
+				return signedHeader(testSigningSecret, testSignatureNow.Add(-SlackSignatureMaxAge-time.Second), body)
+			},
+			body:    body,
+			wantErr: true,
+		},
+		{
+			name: "timestamp in the future",
+			header: func() http.Header {
+				return signedHeader(testSigningSecret, testSignatureNow.Add(SlackSignatureMaxAge+time.Second), body)
+			},
+			body:    body,
+			wantErr: true,
+		},
+		{
+			name: "missing signature",
+			header: func() http.Header {
+				h := signedHeader(testSigningSecret, testSignatureNow, body)
+				h.Del("X-Slack-Signature")
+				return h
+			},
+			body:    body,
+			wantErr: true,
+		},
+		{
+			name: "missing timestamp",
+			header: func() http.Header {
+				h := signedHeader(testSigningSecret, testSignatureNow, body)
+				h.Del("X-Slack-Request-Timestamp")
+				return h
+			},
+			body:    body,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifySlackSignature(testSigningSecret, tt.header(), []byte(tt.body), testSignatureNow)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("VerifySlackSignature() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSlackSignatureMiddleware(t *testing.T) {
+	body := "command=%2Faddrca&text=%28DB+down%29"
+
+	tests := []struct {
+		name       string
+		mw         SlackSignatureMiddleware
+		header     http.Header
+		wantStatus int
+		wantNext   bool
+	}{
+		{
+			name:       "valid",
+			mw:         SlackSignatureMiddleware{Secret: testSigningSecret},
+			header:     signedHeader(testSigningSecret, testSignatureNow, body),
+			wantStatus: http.StatusOK,
+			wantNext:   true,
+		},
+		{
+			name:       "forged",
+			mw:         SlackSignatureMiddleware{Secret: testSigningSecret},
+			header:     signedHeader("not-the-secret", testSignatureNow, body),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "secret not set",
+			mw:         SlackSignatureMiddleware{},
+			header:     signedHeader(testSigningSecret, testSignatureNow, body),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "skipped on purpose",
+			mw:         SlackSignatureMiddleware{Skip: true},
+			header:     http.Header{},
+			wantStatus: http.StatusOK,
+			wantNext:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mw := tt.mw
+			mw.Now = func() time.Time { return testSignatureNow }
+
+			req := httptest.NewRequest(http.MethodPost, "/rca", strings.NewReader(body))
+			req.Header = tt.header
+
+			called, gotBody := false, ""
+			rec := httptest.NewRecorder()
+			mw.ServeHTTP(rec, req, func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				b, _ := ioutil.ReadAll(r.Body)
+				gotBody = string(b)
+			})
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if called != tt.wantNext {
+				t.Errorf("next called = %v, want %v", called, tt.wantNext)
+			}
+			if called && gotBody != body {
+				t.Errorf("next got body %q, want %q", gotBody, body)
+			}
+		})
+	}
+}
+
+func TestSlackSignatureMiddlewareBodyLimit(t *testing.T) {
+	body := strings.Repeat("a", SlackMaxRequestBody+1)
+	mw := SlackSignatureMiddleware{Secret: testSigningSecret, Now: func() time.Time { return testSignatureNow }}
+
+	req := httptest.NewRequest(http.MethodPost, "/rca", strings.NewReader(body))
+	req.Header = signedHeader(testSigningSecret, testSignatureNow, body)
+
+	rec := httptest.NewRecorder()
+	mw.ServeHTTP(rec, req, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("next called with an oversized body")
+	})
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
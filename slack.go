@@ -12,6 +12,9 @@ import (
 
 const (
 	DevelopmentEnv = "development"
+
+	ResponseInChannel = "in_channel"
+	ResponseEphemeral = "ephemeral"
 )
 
 var (
@@ -48,10 +51,20 @@ type SlackMsgStructure struct {
 	Blocks []BlockStructure `json:"blocks"`
 }
 
+// SlackResponse is a reply sent to the response_url of a slash command or an
+// interaction, ReplaceOriginal swaps the message a button was clicked on.
+type SlackResponse struct {
+	ResponseType    string           `json:"response_type,omitempty"`
+	ReplaceOriginal bool             `json:"replace_original,omitempty"`
+	Text            string           `json:"text,omitempty"`
+	Blocks          []BlockStructure `json:"blocks,omitempty"`
+}
+
 type BlockStructure struct {
-	Type      string     `json:"type,omitempty"`
-	Text      *BlockText `json:"text,omitempty"`
-	Accessory *BlockAcc  `json:"accessory,omitempty"`
+	Type      string      `json:"type,omitempty"`
+	Text      *BlockText  `json:"text,omitempty"`
+	Accessory *BlockAcc   `json:"accessory,omitempty"`
+	Elements  []*BlockAcc `json:"elements,omitempty"`
 }
 
 type BlockText struct {
@@ -59,10 +72,15 @@ type BlockText struct {
 	Text string `json:"text,omitempty"`
 }
 
+// BlockAcc is a button, or a select menu when it has Options.
 type BlockAcc struct {
-	Type  string       `json:"type,omitempty"`
-	Text  BlockAccText `json:"text,omitempty"`
-	Value string       `json:"value,omitempty"`
+	Type        string        `json:"type,omitempty"`
+	ActionID    string        `json:"action_id,omitempty"`
+	Text        *BlockAccText `json:"text,omitempty"`
+	Placeholder *BlockAccText `json:"placeholder,omitempty"`
+	Value       string        `json:"value,omitempty"`
+	Style       string        `json:"style,omitempty"`
+	Options     []ViewOption  `json:"options,omitempty"`
 }
 
 type BlockAccText struct {
@@ -72,11 +90,20 @@ type BlockAccText struct {
 }
 
 func (s *SlackModule) PublishSlack(slackMsg SlackMsgStructure) error {
+	return s.publish(slackMsg)
+}
+
+// PublishResponse posts to a response_url, which is what Webhook holds then.
+func (s *SlackModule) PublishResponse(resp SlackResponse) error {
+	return s.publish(resp)
+}
+
+func (s *SlackModule) publish(payload interface{}) error {
 	if s.Environment == DevelopmentEnv {
 		return nil
 	}
 
-	b, _ := json.Marshal(slackMsg)
+	b, _ := json.Marshal(payload)
 
 	resp, err := s.Client.Post(s.Webhook, "application/json", bytes.NewBuffer(b))
 	if err != nil {
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	SlackAPIBaseURL = "https://slack.com/api/"
+)
+
+var (
+	slackAPI *SlackAPI //nil when SLACK_BOT_TOKEN is not set
+)
+
+// SlackAPI calls the Slack Web API with a bot token, so the bot can post to
+// every channel it is a member of without an incoming webhook.
+type SlackAPI struct {
+	Token   string
+	BaseURL string
+	Client  *http.Client
+}
+
+type SlackUser struct {
+	ID       string           `json:"id"`
+	Name     string           `json:"name"`
+	RealName string           `json:"real_name"`
+	Deleted  bool             `json:"deleted"`
+	Profile  SlackUserProfile `json:"profile"`
+}
+
+type SlackUserProfile struct {
+	DisplayName string `json:"display_name"`
+	RealName    string `json:"real_name"`
+}
+
+type slackAPIResponse struct {
+	OK      bool      `json:"ok"`
+	Error   string    `json:"error"`
+	TS      string    `json:"ts"`
+	Channel string    `json:"channel"`
+	User    SlackUser `json:"user"`
+
+	Members          []SlackUser `json:"members"`
+	ResponseMetadata struct {
+		NextCursor string `json:"next_cursor"`
+	} `json:"response_metadata"`
+}
+
+func NewSlackAPI(token string) *SlackAPI {
+	return &SlackAPI{
+		Token:   token,
+		BaseURL: SlackAPIBaseURL,
+		Client: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+	}
+}
+
+// InitSlackAPI reads SLACK_BOT_TOKEN, without it every message goes through
+// the channel webhook as before.
+func InitSlackAPI() {
+	token := strings.TrimSpace(os.Getenv("SLACK_BOT_TOKEN"))
+	if token == "" {
+		Println(nil, "[!!!] SLACK_BOT_TOKEN not set, posting through channel webhooks only")
+		return
+	}
+
+	slackAPI = NewSlackAPI(token)
+}
+
+// PostMessage posts to a channel and returns the message timestamp, which
+// identifies the message for UpdateMessage.
+func (s *SlackAPI) PostMessage(channelID string, msg SlackMsgStructure) (string, error) {
+	res, err := s.callJSON("chat.postMessage", map[string]interface{}{
+		"channel": channelID,
+		"blocks":  msg.Blocks,
+		"text":    slackFallbackText(msg),
+	})
+
+	return res.TS, err
+}
+
+func (s *SlackAPI) UpdateMessage(channelID, ts string, msg SlackMsgStructure) error {
+	_, err := s.callJSON("chat.update", map[string]interface{}{
+		"channel": channelID,
+		"ts":      ts,
+		"blocks":  msg.Blocks,
+		"text":    slackFallbackText(msg),
+	})
+
+	return err
+}
+
+// OpenView opens a modal, triggerID comes from the slash command or the
+// interaction that asked for it and expires after 3 seconds.
+func (s *SlackAPI) OpenView(triggerID string, view interface{}) error {
+	_, err := s.callJSON("views.open", map[string]interface{}{
+		"trigger_id": triggerID,
+		"view":       view,
+	})
+
+	return err
+}
+
+func (s *SlackAPI) UserInfo(userID string) (SlackUser, error) {
+	res, err := s.callForm("users.info", url.Values{"user": {userID}})
+	return res.User, err
+}
+
+// ListUsers pages through users.list, it needs the users:read scope.
+func (s *SlackAPI) ListUsers() ([]SlackUser, error) {
+	users := []SlackUser{}
+	cursor := ""
+
+	for {
+		res, err := s.callForm("users.list", url.Values{"limit": {"200"}, "cursor": {cursor}})
+		if err != nil {
+			return users, err
+		}
+
+		users = append(users, res.Members...)
+
+		cursor = res.ResponseMetadata.NextCursor
+		if cursor == "" {
+			return users, nil
+		}
+	}
+}
+
+func (s *SlackAPI) callJSON(method string, payload interface{}) (slackAPIResponse, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return slackAPIResponse{}, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.BaseURL+method, bytes.NewReader(b))
+	if err != nil {
+		return slackAPIResponse{}, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	return s.do(method, req)
+}
+
+// callForm is for the read methods, they do not accept JSON bodies.
+func (s *SlackAPI) callForm(method string, values url.Values) (slackAPIResponse, error) {
+	req, err := http.NewRequest(http.MethodPost, s.BaseURL+method, strings.NewReader(values.Encode()))
+	if err != nil {
+		return slackAPIResponse{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return s.do(method, req)
+}
+
+func (s *SlackAPI) do(method string, req *http.Request) (slackAPIResponse, error) {
+	var res slackAPIResponse
+	req.Header.Set("Authorization", "Bearer "+s.Token)
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return res, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return res, errors.New(fmt.Sprintf("%s: invalid code got: %d", method, resp.StatusCode))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return res, err
+	}
+
+	if !res.OK {
+		return res, errors.New(fmt.Sprintf("%s: %s", method, res.Error))
+	}
+
+	return res, nil
+}
+
+// slackFallbackText is the notification text Slack shows when blocks cannot
+// be rendered, the first section is enough.
+func slackFallbackText(msg SlackMsgStructure) string {
+	for _, b := range msg.Blocks {
+		if b.Text != nil && b.Text.Text != "" {
+			return b.Text.Text
+		}
+	}
+
+	return "RCA"
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultSnoozeDays = 1
+	MaxSnoozeDays     = 90
+)
+
+// IsSnoozed reports whether an active item is left out of the scheduled list
+// and the overdue reminder. SnoozedUntil is the first day it shows again.
+func IsSnoozed(is RCAData, now time.Time) bool {
+	if is.SnoozedUntil == "" || !is.Status.IsActive() {
+		return false
+	}
+
+	return now.Format(DueDateFormat) < is.SnoozedUntil
+}
+
+// ParseSnoozeUntil reads a number of days or a YYYY-MM-DD date, empty text
+// is one day and `off` wakes the item up.
+func ParseSnoozeUntil(text string, now time.Time) (string, error) {
+	text = strings.ToLower(strings.TrimSpace(text))
+
+	switch text {
+	case "":
+		return now.AddDate(0, 0, DefaultSnoozeDays).Format(DueDateFormat), nil
+	case "off", "none", "-", "0":
+		return "", nil
+	}
+
+	if days, err := strconv.Atoi(strings.TrimSuffix(text, "d")); err == nil {
+		if days < 1 || days > MaxSnoozeDays {
+			return "", MsgError("snooze.invalid_days", text, MaxSnoozeDays)
+		}
+
+		return now.AddDate(0, 0, days).Format(DueDateFormat), nil
+	}
+
+	until, err := time.ParseInLocation(DueDateFormat, text, time.Local)
+	if err != nil || until.Format(DueDateFormat) <= now.Format(DueDateFormat) {
+		return "", MsgError("snooze.invalid", text)
+	}
+
+	return until.Format(DueDateFormat), nil
+}
+
+func SnoozeRCA(uname, channelID, text string) (string, error) {
+	desc := strings.Fields(text)
+
+	if len(desc) < 1 {
+		return "", MsgError("snooze.invalid", text)
+	}
+
+	until, err := ParseSnoozeUntil(strings.Join(desc[1:], " "), time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	issueID, v, err := FindRCA(channelID, desc[0])
+	if err != nil {
+		return "", err
+	}
+
+	if v.Title == "" {
+		return "", MsgError("rca.invalid_id", desc[0], Msg("action.snooze"), uname)
+	}
+
+	if !v.Status.IsActive() {
+		return "", MsgError("snooze.not_active", v.Title, v.DisplayID(issueID), v.Status)
+	}
+
+	oldUntil := ""
+	err = Store.UpdateRCA(channelID, issueID, func(data *RCAData) error {
+		if data.Title == "" {
+			return ErrRCANotFound
+		}
+
+		oldUntil = data.SnoozedUntil
+		data.SnoozedUntil = until
+		return nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	RecordRCAEvent(channelID, issueID, uname, "/snoozerca", "SnoozedUntil", oldUntil, until)
+
+	lang := ChannelLanguage(channelID)
+	if until == "" {
+		return T(lang, "snooze.cleared", v.Title, v.DisplayID(issueID), uname), nil
+	}
+
+	return T(lang, "snooze.set", v.Title, v.DisplayID(issueID), until, uname), nil
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RCAStatus is persisted as a plain int, the first values keep the meaning
+// they had before stages existed (0 active, 1 done, 3 removed).
+type RCAStatus int
+
+const (
+	StatusOpen          RCAStatus = 0
+	StatusDone          RCAStatus = 1
+	StatusRemoved       RCAStatus = 3
+	StatusInvestigating RCAStatus = 4
+	StatusDeckDrafting  RCAStatus = 5
+	StatusReview        RCAStatus = 6
+	StatusPresented     RCAStatus = 7
+)
+
+var (
+	// ActiveStatuses is the order stages are shown in the active list.
+	ActiveStatuses = []RCAStatus{StatusOpen, StatusInvestigating, StatusDeckDrafting, StatusReview, StatusPresented}
+
+	statusNames = map[RCAStatus]string{
+		StatusOpen:          "Open",
+		StatusInvestigating: "Investigating",
+		StatusDeckDrafting:  "Deck Drafting",
+		StatusReview:        "Review",
+		StatusPresented:     "Presented",
+		StatusDone:          "Done",
+		StatusRemoved:       "Removed",
+	}
+
+	// statusTransitions lists where each stage may move to. Leaving Removed
+	// only happens through /restorerca.
+	statusTransitions = map[RCAStatus][]RCAStatus{
+		StatusOpen:          {StatusInvestigating, StatusDeckDrafting, StatusDone, StatusRemoved},
+		StatusInvestigating: {StatusOpen, StatusDeckDrafting, StatusDone, StatusRemoved},
+		StatusDeckDrafting:  {StatusInvestigating, StatusReview, StatusDone, StatusRemoved},
+		StatusReview:        {StatusDeckDrafting, StatusPresented, StatusDone, StatusRemoved},
+		StatusPresented:     {StatusReview, StatusDone, StatusRemoved},
+		StatusDone:          {StatusOpen, StatusRemoved},
+		StatusRemoved:       {},
+	}
+)
+
+func (s RCAStatus) String() string {
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+
+	return strconv.Itoa(int(s))
+}
+
+func (s RCAStatus) IsValid() bool {
+	_, ok := statusNames[s]
+	return ok
+}
+
+func (s RCAStatus) IsActive() bool {
+	return s != StatusDone && s != StatusRemoved
+}
+
+// Emoji is the icon used for items of this status in RCA lists.
+func (s RCAStatus) Emoji() string {
+	switch s {
+	case StatusDone:
+		return "white_check_mark"
+	case StatusRemoved:
+		return "wastebasket"
+	}
+
+	return "bangbang"
+}
+
+func (s RCAStatus) CanTransitionTo(to RCAStatus) bool {
+	for _, next := range statusTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s RCAStatus) AllowedTransitions() []string {
+	names := []string{}
+	for _, next := range statusTransitions[s] {
+		names = append(names, next.String())
+	}
+
+	return names
+}
+
+// ParseRCAStatus accepts stage names in any case with spaces, dashes or
+// underscores, e.g. "deck-drafting" or "Deck Drafting".
+func ParseRCAStatus(text string) (RCAStatus, error) {
+	key := normalizeStatusName(text)
+
+	for status, name := range statusNames {
+		if normalizeStatusName(name) == key {
+			return status, nil
+		}
+	}
+
+	return StatusOpen, MsgError("status.unknown", text, strings.Join(StatusNames(), ", "))
+}
+
+func StatusNames() []string {
+	names := []string{}
+	for _, status := range append(append([]RCAStatus{}, ActiveStatuses...), StatusDone, StatusRemoved) {
+		names = append(names, status.String())
+	}
+
+	return names
+}
+
+func normalizeStatusName(text string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.ToLower(strings.TrimSpace(text)))
+}
+
+func SetStatus(uname, channelID, text string) (string, error) {
+	desc := strings.Fields(text)
+
+	force := false
+	if len(desc) > 2 && desc[len(desc)-1] == "force" {
+		force = true
+		desc = desc[:len(desc)-1]
+	}
+
+	if len(desc) < 2 {
+		return "", MsgError("status.invalid", strings.Join(StatusNames(), ", "))
+	}
+
+	status, err := ParseRCAStatus(strings.Join(desc[1:], " "))
+	if err != nil {
+		return "", err
+	}
+
+	if status == StatusRemoved {
+		return "", MsgError("status.use_remove")
+	}
+
+	issueID, v, err := FindRCA(channelID, desc[0])
+	if err != nil {
+		return "", err
+	}
+
+	if v.Title == "" {
+		return "", MsgError("rca.invalid_id", desc[0], Msg("action.set_stage"), uname)
+	}
+
+	if err := SetRCAStatus(uname, "/setstatus", channelID, issueID, status, force); err != nil {
+		return "", err
+	}
+
+	return T(ChannelLanguage(channelID), "status.moved", v.Title, v.DisplayID(issueID), status, uname), nil
+}
+
+// SetRCAStatus moves an item to a new stage, refusing moves that are not in
+// the transition table. force lets Done through with open action items.
+func SetRCAStatus(uname, command, channelID, issueID string, status RCAStatus, force bool) error {
+	oldStatus := status
+	err := Store.UpdateRCA(channelID, issueID, func(data *RCAData) error {
+		if data.Title == "" {
+			return ErrRCANotFound
+		}
+
+		oldStatus = data.Status
+		return ApplyRCAStatus(issueID, data, status, force)
+	})
+
+	if err == nil {
+		RecordRCAEvent(channelID, issueID, uname, command, "Status", oldStatus.String(), status.String())
+	}
+
+	return err
+}
+
+// ApplyRCAStatus moves data to status when the transition is allowed and
+// keeps the removed/done bookkeeping in sync. Done is refused while action
+// items are open, unless force is set.
+func ApplyRCAStatus(issueID string, data *RCAData, status RCAStatus, force bool) error {
+	if data.Status == status {
+		return MsgError("status.already", data.Title, data.DisplayID(issueID), status)
+	}
+
+	if !data.Status.CanTransitionTo(status) {
+		return MsgError("status.transition", data.Title, data.DisplayID(issueID), data.Status, status, strings.Join(data.Status.AllowedTransitions(), ", "))
+	}
+
+	if status == StatusDone && !force && data.OpenActionCount() > 0 {
+		return MsgError("status.open_actions", data.Title, data.DisplayID(issueID), data.OpenActionCount(), data.DisplayID(issueID))
+	}
+
+	if status == StatusRemoved {
+		data.PrevStatus = data.Status
+		data.RemovedAt = time.Now().Unix()
+	}
+
+	if status == StatusDone {
+		data.DoneAt = time.Now().Unix()
+	} else if data.Status == StatusDone && status != StatusRemoved {
+		data.DoneAt = 0
+	}
+
+	data.Status = status
+	return nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+)
+
+const (
+	StoreFirebase = "firebase"
+	StoreMemory   = "memory"
+	StoreBolt     = "bolt"
+
+	DefaultBoltPath = "./internalrca.db"
+)
+
+var (
+	ErrRCANotFound = MsgError("store.rca_not_found")
+)
+
+// RCAStore is the storage used by every command handler. Implementations must
+// make UpdateRCA atomic for the given item, the same way a Firebase
+// transaction does, and UpdateRCAs all-or-nothing for the given items.
+type RCAStore interface {
+	GetChannel(channelID string) (Channel, error)
+	GetAllChannels() (map[string]Channel, error)
+	SetChannelKey(channelID, channelKey string) error
+	SetFooter(channelID, footer string) error
+	SetLanguage(channelID, language string) error
+	NextIssueNumber(channelID string) (int, error)
+	SetIssueSeq(channelID string, seq int) error
+	SetIssueAlias(channelID, alias, issueID string) error
+
+	GetRCA(channelID, issueID string) (RCAData, error)
+	SetRCA(channelID, issueID string, data RCAData) error
+	UpdateRCA(channelID, issueID string, fn func(data *RCAData) error) error
+	UpdateRCAs(channelID string, issueIDs []string, fn func(issueID string, data *RCAData) error) error
+	DeleteRCA(channelID, issueID string) error
+
+	AppendRCAEvent(channelID, issueID string, ev RCAEvent) error
+	GetRCAHistory(channelID, issueID string) ([]RCAEvent, error)
+	SetRCAHistory(channelID, issueID string, events []RCAEvent) error
+
+	GetAllSchedules() (map[string]string, error)
+	SetSchedule(channelID, interval string) error
+	RemoveSchedule(channelID string) error
+}
+
+func NewRCAStore(backend string) (RCAStore, error) {
+	switch strings.ToLower(backend) {
+	case "", StoreFirebase:
+		client, err := NewFirebaseClient(os.Getenv("DB_URL"), os.Getenv("FB_CRED"))
+		if err != nil {
+			return nil, err
+		}
+		return NewFirebaseStore(client), nil
+	case StoreMemory:
+		return NewMemoryStore(), nil
+	case StoreBolt:
+		path := os.Getenv("BOLT_PATH")
+		if path == "" {
+			path = DefaultBoltPath
+		}
+		return NewBoltStore(path)
+	}
+
+	return nil, MsgError("store.unknown_backend", backend)
+}
+
+func copyStoreData(dst, src interface{}) error {
+	b, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, dst)
+}
@@ -0,0 +1,306 @@
+package main
+
+import (
+	"encoding/json"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	boltChannelBucket   = []byte("Channel")
+	boltSchedulerBucket = []byte("Scheduler")
+	boltHistoryBucket   = []byte("History")
+)
+
+// BoltStore persists the Channel and Scheduler trees in a local bbolt file.
+// Every channel is kept as one JSON document so that a single bolt
+// transaction covers any read-modify-write on it.
+type BoltStore struct {
+	DB *bolt.DB
+}
+
+func NewBoltStore(path string) (*BoltStore, error) {
+	boltDB, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 2 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	err = boltDB.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(boltChannelBucket); err != nil {
+			return err
+		}
+
+		if _, err := tx.CreateBucketIfNotExists(boltSchedulerBucket); err != nil {
+			return err
+		}
+
+		_, err := tx.CreateBucketIfNotExists(boltHistoryBucket)
+		return err
+	})
+
+	if err != nil {
+		boltDB.Close()
+		return nil, err
+	}
+
+	return &BoltStore{
+		DB: boltDB,
+	}, nil
+}
+
+func (b *BoltStore) GetChannel(channelID string) (Channel, error) {
+	var v Channel
+
+	err := b.DB.View(func(tx *bolt.Tx) error {
+		var err error
+		v, err = getBoltChannel(tx, channelID)
+		return err
+	})
+
+	return v, err
+}
+
+func (b *BoltStore) GetAllChannels() (map[string]Channel, error) {
+	channels := map[string]Channel{}
+
+	err := b.DB.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltChannelBucket).ForEach(func(k, raw []byte) error {
+			var v Channel
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return err
+			}
+
+			channels[string(k)] = v
+			return nil
+		})
+	})
+
+	return channels, err
+}
+
+func (b *BoltStore) SetChannelKey(channelID, channelKey string) error {
+	return b.updateChannel(channelID, func(ch *Channel) error {
+		ch.ChannelKey = channelKey
+		return nil
+	})
+}
+
+func (b *BoltStore) SetFooter(channelID, footer string) error {
+	return b.updateChannel(channelID, func(ch *Channel) error {
+		ch.Footer = footer
+		return nil
+	})
+}
+
+func (b *BoltStore) SetLanguage(channelID, language string) error {
+	return b.updateChannel(channelID, func(ch *Channel) error {
+		ch.Language = language
+		return nil
+	})
+}
+
+func (b *BoltStore) NextIssueNumber(channelID string) (int, error) {
+	seq := 0
+	err := b.updateChannel(channelID, func(ch *Channel) error {
+		ch.Seq++
+		seq = ch.Seq
+		return nil
+	})
+
+	return seq, err
+}
+
+func (b *BoltStore) SetIssueSeq(channelID string, seq int) error {
+	return b.updateChannel(channelID, func(ch *Channel) error {
+		ch.Seq = seq
+		return nil
+	})
+}
+
+func (b *BoltStore) SetIssueAlias(channelID, alias, issueID string) error {
+	return b.updateChannel(channelID, func(ch *Channel) error {
+		if ch.Alias == nil {
+			ch.Alias = map[string]string{}
+		}
+
+		ch.Alias[alias] = issueID
+		return nil
+	})
+}
+
+func (b *BoltStore) GetRCA(channelID, issueID string) (RCAData, error) {
+	ch, err := b.GetChannel(channelID)
+	return ch.Data[issueID], err
+}
+
+func (b *BoltStore) SetRCA(channelID, issueID string, data RCAData) error {
+	return b.updateChannel(channelID, func(ch *Channel) error {
+		ch.Data[issueID] = data
+		return nil
+	})
+}
+
+func (b *BoltStore) UpdateRCA(channelID, issueID string, fn func(data *RCAData) error) error {
+	return b.updateChannel(channelID, func(ch *Channel) error {
+		v := ch.Data[issueID]
+		if err := fn(&v); err != nil {
+			return err
+		}
+
+		ch.Data[issueID] = v
+		return nil
+	})
+}
+
+func (b *BoltStore) UpdateRCAs(channelID string, issueIDs []string, fn func(issueID string, data *RCAData) error) error {
+	return b.updateChannel(channelID, func(ch *Channel) error {
+		for _, issueID := range issueIDs {
+			v := ch.Data[issueID]
+			if err := fn(issueID, &v); err != nil {
+				return err
+			}
+
+			ch.Data[issueID] = v
+		}
+		return nil
+	})
+}
+
+func (b *BoltStore) DeleteRCA(channelID, issueID string) error {
+	return b.DB.Update(func(tx *bolt.Tx) error {
+		ch, err := getBoltChannel(tx, channelID)
+		if err != nil {
+			return err
+		}
+
+		delete(ch.Data, issueID)
+
+		raw, err := json.Marshal(ch)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Bucket(boltChannelBucket).Put([]byte(channelID), raw); err != nil {
+			return err
+		}
+
+		return tx.Bucket(boltHistoryBucket).Delete(boltHistoryKey(channelID, issueID))
+	})
+}
+
+func (b *BoltStore) GetAllSchedules() (map[string]string, error) {
+	schedules := map[string]string{}
+
+	err := b.DB.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltSchedulerBucket).ForEach(func(k, v []byte) error {
+			schedules[string(k)] = string(v)
+			return nil
+		})
+	})
+
+	return schedules, err
+}
+
+func (b *BoltStore) SetSchedule(channelID, interval string) error {
+	return b.DB.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltSchedulerBucket).Put([]byte(channelID), []byte(interval))
+	})
+}
+
+func (b *BoltStore) RemoveSchedule(channelID string) error {
+	return b.DB.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltSchedulerBucket).Delete([]byte(channelID))
+	})
+}
+
+func (b *BoltStore) AppendRCAEvent(channelID, issueID string, ev RCAEvent) error {
+	return b.DB.Update(func(tx *bolt.Tx) error {
+		events, err := getBoltHistory(tx, channelID, issueID)
+		if err != nil {
+			return err
+		}
+
+		return putBoltHistory(tx, channelID, issueID, append(events, ev))
+	})
+}
+
+func (b *BoltStore) GetRCAHistory(channelID, issueID string) ([]RCAEvent, error) {
+	var events []RCAEvent
+
+	err := b.DB.View(func(tx *bolt.Tx) error {
+		var err error
+		events, err = getBoltHistory(tx, channelID, issueID)
+		return err
+	})
+
+	SortRCAEvents(events)
+	return events, err
+}
+
+func (b *BoltStore) SetRCAHistory(channelID, issueID string, events []RCAEvent) error {
+	return b.DB.Update(func(tx *bolt.Tx) error {
+		return putBoltHistory(tx, channelID, issueID, events)
+	})
+}
+
+func (b *BoltStore) updateChannel(channelID string, fn func(ch *Channel) error) error {
+	return b.DB.Update(func(tx *bolt.Tx) error {
+		ch, err := getBoltChannel(tx, channelID)
+		if err != nil {
+			return err
+		}
+
+		if ch.Data == nil {
+			ch.Data = map[string]RCAData{}
+		}
+
+		if err := fn(&ch); err != nil {
+			return err
+		}
+
+		raw, err := json.Marshal(ch)
+		if err != nil {
+			return err
+		}
+
+		return tx.Bucket(boltChannelBucket).Put([]byte(channelID), raw)
+	})
+}
+
+func getBoltChannel(tx *bolt.Tx, channelID string) (Channel, error) {
+	var v Channel
+
+	raw := tx.Bucket(boltChannelBucket).Get([]byte(channelID))
+	if raw == nil {
+		return v, nil
+	}
+
+	err := json.Unmarshal(raw, &v)
+	return v, err
+}
+
+func boltHistoryKey(channelID, issueID string) []byte {
+	return []byte(channelID + "/" + issueID)
+}
+
+func getBoltHistory(tx *bolt.Tx, channelID, issueID string) ([]RCAEvent, error) {
+	events := []RCAEvent{}
+
+	raw := tx.Bucket(boltHistoryBucket).Get(boltHistoryKey(channelID, issueID))
+	if raw == nil {
+		return events, nil
+	}
+
+	err := json.Unmarshal(raw, &events)
+	return events, err
+}
+
+func putBoltHistory(tx *bolt.Tx, channelID, issueID string, events []RCAEvent) error {
+	raw, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+
+	return tx.Bucket(boltHistoryBucket).Put(boltHistoryKey(channelID, issueID), raw)
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	firebase "firebase.google.com/go"
+	"firebase.google.com/go/db"
+	"google.golang.org/api/option"
+)
+
+type FirebaseStore struct {
+	Client *db.Client
+}
+
+func NewFirebaseClient(dbURL, cred string) (*db.Client, error) {
+	ctx := context.Background()
+	conf := &firebase.Config{
+		DatabaseURL: dbURL,
+	}
+
+	var credData FBCred
+	json.Unmarshal([]byte(cred), &credData)
+	file, _ := json.MarshalIndent(credData, "", " ")
+	ioutil.WriteFile("./secret.json", file, 0644)
+
+	opt := option.WithCredentialsFile("./secret.json")
+	app, err := firebase.NewApp(ctx, conf, opt)
+	if err != nil {
+		return nil, fmt.Errorf("firebase.NewApp: %v", err)
+	}
+
+	client, err := app.Database(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("app.Database: %v", err)
+	}
+
+	return client, nil
+}
+
+func NewFirebaseStore(client *db.Client) *FirebaseStore {
+	return &FirebaseStore{
+		Client: client,
+	}
+}
+
+func (f *FirebaseStore) GetChannel(channelID string) (Channel, error) {
+	var v Channel
+	ctx := context.Background()
+	err := f.Client.NewRef(fmt.Sprintf("Channel/%s", channelID)).Get(ctx, &v)
+	return v, err
+}
+
+func (f *FirebaseStore) GetAllChannels() (map[string]Channel, error) {
+	var channels map[string]Channel
+	ctx := context.Background()
+	err := f.Client.NewRef("Channel").Get(ctx, &channels)
+	return channels, err
+}
+
+func (f *FirebaseStore) SetChannelKey(channelID, channelKey string) error {
+	return f.setValue(fmt.Sprintf("Channel/%s/channelKey", channelID), channelKey)
+}
+
+func (f *FirebaseStore) SetFooter(channelID, footer string) error {
+	return f.setValue(fmt.Sprintf("Channel/%s/Footer", channelID), footer)
+}
+
+func (f *FirebaseStore) SetLanguage(channelID, language string) error {
+	return f.setValue(fmt.Sprintf("Channel/%s/language", channelID), language)
+}
+
+func (f *FirebaseStore) NextIssueNumber(channelID string) (int, error) {
+	ctx := context.Background()
+
+	seq := 0
+	updateTxn := func(node db.TransactionNode) (interface{}, error) {
+		var current int
+		if err := node.Unmarshal(&current); err != nil {
+			return nil, err
+		}
+
+		seq = current + 1
+		return seq, nil
+	}
+
+	err := f.Client.NewRef(fmt.Sprintf("Channel/%s/seq", channelID)).Transaction(ctx, updateTxn)
+	return seq, err
+}
+
+func (f *FirebaseStore) SetIssueSeq(channelID string, seq int) error {
+	return f.setValue(fmt.Sprintf("Channel/%s/seq", channelID), seq)
+}
+
+func (f *FirebaseStore) SetIssueAlias(channelID, alias, issueID string) error {
+	return f.setValue(fmt.Sprintf("Channel/%s/alias/%s", channelID, alias), issueID)
+}
+
+func (f *FirebaseStore) GetRCA(channelID, issueID string) (RCAData, error) {
+	var v RCAData
+	ctx := context.Background()
+	err := f.Client.NewRef(fmt.Sprintf("Channel/%s/data/%s", channelID, issueID)).Get(ctx, &v)
+	return v, err
+}
+
+func (f *FirebaseStore) SetRCA(channelID, issueID string, data RCAData) error {
+	ctx := context.Background()
+	return f.Client.NewRef(fmt.Sprintf("Channel/%s/data/%s", channelID, issueID)).Set(ctx, data)
+}
+
+func (f *FirebaseStore) UpdateRCA(channelID, issueID string, fn func(data *RCAData) error) error {
+	ctx := context.Background()
+
+	updateTxn := func(node db.TransactionNode) (interface{}, error) {
+		var v RCAData
+		if err := node.Unmarshal(&v); err != nil {
+			return nil, err
+		}
+
+		if err := fn(&v); err != nil {
+			return nil, err
+		}
+
+		return v, nil
+	}
+
+	return f.Client.NewRef(fmt.Sprintf("Channel/%s/data/%s", channelID, issueID)).Transaction(ctx, updateTxn)
+}
+
+// UpdateRCAs runs one transaction over the whole data node of the channel so
+// every item is written or none is.
+func (f *FirebaseStore) UpdateRCAs(channelID string, issueIDs []string, fn func(issueID string, data *RCAData) error) error {
+	ctx := context.Background()
+
+	updateTxn := func(node db.TransactionNode) (interface{}, error) {
+		var data map[string]RCAData
+		if err := node.Unmarshal(&data); err != nil {
+			return nil, err
+		}
+
+		if data == nil {
+			data = map[string]RCAData{}
+		}
+
+		for _, issueID := range issueIDs {
+			v := data[issueID]
+			if err := fn(issueID, &v); err != nil {
+				return nil, err
+			}
+
+			data[issueID] = v
+		}
+
+		return data, nil
+	}
+
+	return f.Client.NewRef(fmt.Sprintf("Channel/%s/data", channelID)).Transaction(ctx, updateTxn)
+}
+
+func (f *FirebaseStore) DeleteRCA(channelID, issueID string) error {
+	ctx := context.Background()
+	if err := f.Client.NewRef(fmt.Sprintf("Channel/%s/data/%s", channelID, issueID)).Delete(ctx); err != nil {
+		return err
+	}
+
+	if err := f.Client.NewRef(legacyHistoryPath(channelID, issueID)).Delete(ctx); err != nil {
+		return err
+	}
+
+	return f.Client.NewRef(historyPath(channelID, issueID)).Delete(ctx)
+}
+
+func (f *FirebaseStore) GetAllSchedules() (map[string]string, error) {
+	var scheduler map[string]string
+	ctx := context.Background()
+	err := f.Client.NewRef("Scheduler").Get(ctx, &scheduler)
+	return scheduler, err
+}
+
+func (f *FirebaseStore) SetSchedule(channelID, interval string) error {
+	return f.setValue(fmt.Sprintf("Scheduler/%s", channelID), interval)
+}
+
+func (f *FirebaseStore) RemoveSchedule(channelID string) error {
+	ctx := context.Background()
+	return f.Client.NewRef(fmt.Sprintf("Scheduler/%s", channelID)).Delete(ctx)
+}
+
+func (f *FirebaseStore) setValue(path string, value interface{}) error {
+	ctx := context.Background()
+
+	updateTxn := func(node db.TransactionNode) (interface{}, error) {
+		return value, nil
+	}
+
+	return f.Client.NewRef(path).Transaction(ctx, updateTxn)
+}
+
+// historyPath keeps the audit trail out of the Channel tree, so reading a
+// channel does not download every event of it.
+func historyPath(channelID, issueID string) string {
+	return fmt.Sprintf("History/%s/%s", channelID, issueID)
+}
+
+// legacyHistoryPath is where events were written before the History root,
+// they are still read and are moved by an export and import round trip.
+func legacyHistoryPath(channelID, issueID string) string {
+	return fmt.Sprintf("Channel/%s/history/%s", channelID, issueID)
+}
+
+func (f *FirebaseStore) AppendRCAEvent(channelID, issueID string, ev RCAEvent) error {
+	ctx := context.Background()
+	_, err := f.Client.NewRef(historyPath(channelID, issueID)).Push(ctx, ev)
+	return err
+}
+
+func (f *FirebaseStore) GetRCAHistory(channelID, issueID string) ([]RCAEvent, error) {
+	ctx := context.Background()
+
+	events := []RCAEvent{}
+	for _, path := range []string{legacyHistoryPath(channelID, issueID), historyPath(channelID, issueID)} {
+		var v map[string]RCAEvent
+		if err := f.Client.NewRef(path).Get(ctx, &v); err != nil {
+			return nil, err
+		}
+
+		keys := []string{}
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			events = append(events, v[k])
+		}
+	}
+
+	SortRCAEvents(events)
+	return events, nil
+}
+
+func (f *FirebaseStore) SetRCAHistory(channelID, issueID string, events []RCAEvent) error {
+	v := map[string]RCAEvent{}
+	for i, ev := range events {
+		v[fmt.Sprintf("import-%06d", i)] = ev
+	}
+
+	ctx := context.Background()
+	if err := f.Client.NewRef(historyPath(channelID, issueID)).Set(ctx, v); err != nil {
+		return err
+	}
+
+	return f.Client.NewRef(legacyHistoryPath(channelID, issueID)).Delete(ctx)
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"sync"
+)
+
+// MemoryStore keeps everything in process memory, values are copied in and
+// out so callers never share state with the store.
+type MemoryStore struct {
+	mtx       sync.RWMutex
+	channels  map[string]Channel
+	schedules map[string]string
+	history   map[string]map[string][]RCAEvent
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		channels:  map[string]Channel{},
+		schedules: map[string]string{},
+		history:   map[string]map[string][]RCAEvent{},
+	}
+}
+
+func (m *MemoryStore) GetChannel(channelID string) (Channel, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	var v Channel
+	ch, ok := m.channels[channelID]
+	if !ok {
+		return v, nil
+	}
+
+	err := copyStoreData(&v, ch)
+	return v, err
+}
+
+func (m *MemoryStore) GetAllChannels() (map[string]Channel, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	channels := map[string]Channel{}
+	err := copyStoreData(&channels, m.channels)
+	return channels, err
+}
+
+func (m *MemoryStore) SetChannelKey(channelID, channelKey string) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	ch := m.channels[channelID]
+	ch.ChannelKey = channelKey
+	m.channels[channelID] = ch
+	return nil
+}
+
+func (m *MemoryStore) SetFooter(channelID, footer string) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	ch := m.channels[channelID]
+	ch.Footer = footer
+	m.channels[channelID] = ch
+	return nil
+}
+
+func (m *MemoryStore) SetLanguage(channelID, language string) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	ch := m.channels[channelID]
+	ch.Language = language
+	m.channels[channelID] = ch
+	return nil
+}
+
+func (m *MemoryStore) NextIssueNumber(channelID string) (int, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	ch := m.channels[channelID]
+	ch.Seq++
+	m.channels[channelID] = ch
+	return ch.Seq, nil
+}
+
+func (m *MemoryStore) SetIssueSeq(channelID string, seq int) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	ch := m.channels[channelID]
+	ch.Seq = seq
+	m.channels[channelID] = ch
+	return nil
+}
+
+func (m *MemoryStore) SetIssueAlias(channelID, alias, issueID string) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	ch := m.channels[channelID]
+	if ch.Alias == nil {
+		ch.Alias = map[string]string{}
+	}
+
+	ch.Alias[alias] = issueID
+	m.channels[channelID] = ch
+	return nil
+}
+
+func (m *MemoryStore) GetRCA(channelID, issueID string) (RCAData, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	var v RCAData
+	data, ok := m.channels[channelID].Data[issueID]
+	if !ok {
+		return v, nil
+	}
+
+	err := copyStoreData(&v, data)
+	return v, err
+}
+
+func (m *MemoryStore) SetRCA(channelID, issueID string, data RCAData) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.setRCA(channelID, issueID, data)
+}
+
+func (m *MemoryStore) UpdateRCA(channelID, issueID string, fn func(data *RCAData) error) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	var v RCAData
+	if data, ok := m.channels[channelID].Data[issueID]; ok {
+		if err := copyStoreData(&v, data); err != nil {
+			return err
+		}
+	}
+
+	if err := fn(&v); err != nil {
+		return err
+	}
+
+	return m.setRCA(channelID, issueID, v)
+}
+
+func (m *MemoryStore) UpdateRCAs(channelID string, issueIDs []string, fn func(issueID string, data *RCAData) error) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	updated := map[string]RCAData{}
+	for _, issueID := range issueIDs {
+		var v RCAData
+		if data, ok := m.channels[channelID].Data[issueID]; ok {
+			if err := copyStoreData(&v, data); err != nil {
+				return err
+			}
+		}
+
+		if err := fn(issueID, &v); err != nil {
+			return err
+		}
+		updated[issueID] = v
+	}
+
+	for issueID, v := range updated {
+		if err := m.setRCA(channelID, issueID, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *MemoryStore) DeleteRCA(channelID, issueID string) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	delete(m.channels[channelID].Data, issueID)
+	delete(m.history[channelID], issueID)
+	return nil
+}
+
+func (m *MemoryStore) GetAllSchedules() (map[string]string, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	schedules := map[string]string{}
+	for k, v := range m.schedules {
+		schedules[k] = v
+	}
+
+	return schedules, nil
+}
+
+func (m *MemoryStore) SetSchedule(channelID, interval string) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.schedules[channelID] = interval
+	return nil
+}
+
+func (m *MemoryStore) RemoveSchedule(channelID string) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	delete(m.schedules, channelID)
+	return nil
+}
+
+func (m *MemoryStore) setRCA(channelID, issueID string, data RCAData) error {
+	var v RCAData
+	if err := copyStoreData(&v, data); err != nil {
+		return err
+	}
+
+	ch := m.channels[channelID]
+	if ch.Data == nil {
+		ch.Data = map[string]RCAData{}
+	}
+
+	ch.Data[issueID] = v
+	m.channels[channelID] = ch
+	return nil
+}
+
+func (m *MemoryStore) AppendRCAEvent(channelID, issueID string, ev RCAEvent) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.history[channelID] == nil {
+		m.history[channelID] = map[string][]RCAEvent{}
+	}
+
+	m.history[channelID][issueID] = append(m.history[channelID][issueID], ev)
+	return nil
+}
+
+func (m *MemoryStore) GetRCAHistory(channelID, issueID string) ([]RCAEvent, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	events := append([]RCAEvent{}, m.history[channelID][issueID]...)
+	SortRCAEvents(events)
+	return events, nil
+}
+
+func (m *MemoryStore) SetRCAHistory(channelID, issueID string, events []RCAEvent) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.history[channelID] == nil {
+		m.history[channelID] = map[string][]RCAEvent{}
+	}
+
+	m.history[channelID][issueID] = append([]RCAEvent{}, events...)
+	return nil
+}
@@ -0,0 +1,348 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+// useMemoryStore points the handlers at a fresh MemoryStore, without a bot
+// token and with an empty cron so scheduler changes can register jobs.
+func useMemoryStore(t *testing.T) *MemoryStore {
+	t.Helper()
+
+	store := NewMemoryStore()
+	Store = store
+	slackAPI = nil
+
+	if cronM == nil {
+		cronM = &Cron{listenErrCh: make(chan error)}
+	}
+
+	return store
+}
+
+func mustAddRCA(t *testing.T, channelID, text string) string {
+	t.Helper()
+
+	if _, err := AddRCA("budi", text, channelID); err != nil {
+		t.Fatalf("AddRCA(%q) error: %v", text, err)
+	}
+
+	ch, err := Store.GetChannel(channelID)
+	if err != nil {
+		t.Fatalf("GetChannel error: %v", err)
+	}
+
+	return "RCA-" + strconv.Itoa(ch.Seq)
+}
+
+func TestMemoryStoreAddRCA(t *testing.T) {
+	useMemoryStore(t)
+
+	tests := []struct {
+		name    string
+		text    string
+		wantErr bool
+		check   func(t *testing.T, v RCAData)
+	}{
+		{
+			name: "legacy positional",
+			text: "(DB down) (primary lost) @budi https://pma.example/1 staging",
+			check: func(t *testing.T, v RCAData) {
+				if v.Title != "DB down" || v.Description != "primary lost" || v.Assignee != "@budi" {
+					t.Errorf("fields = %q %q %q", v.Title, v.Description, v.Assignee)
+				}
+				if v.PMA != "https://pma.example/1" || v.Environment != "Staging" {
+					t.Errorf("PMA %q env %q", v.PMA, v.Environment)
+				}
+			},
+		},
+		{
+			name: "flags with defaults",
+			text: `title="Cache miss storm" assignee=@andi sev=2`,
+			check: func(t *testing.T, v RCAData) {
+				if v.Environment != "Production" || v.Severity != 2 || v.Status != StatusOpen {
+					t.Errorf("env %q sev %d status %v", v.Environment, v.Severity, v.Status)
+				}
+				if v.CreatedAt == 0 {
+					t.Error("CreatedAt not set")
+				}
+			},
+		},
+		{
+			name:    "missing assignee",
+			text:    `title="No owner"`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before, _ := Store.GetChannel("C-add")
+
+			_, err := AddRCA("budi", tt.text, "C-add")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+
+				after, _ := Store.GetChannel("C-add")
+				if len(after.Data) != len(before.Data) {
+					t.Errorf("failed add stored an item")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("AddRCA error: %v", err)
+			}
+
+			ch, _ := Store.GetChannel("C-add")
+			issueID := "RCA-" + strconv.Itoa(ch.Seq)
+			v, err := Store.GetRCA("C-add", issueID)
+			if err != nil {
+				t.Fatalf("GetRCA error: %v", err)
+			}
+			if v.ShortID != issueID {
+				t.Errorf("ShortID = %q, want %q", v.ShortID, issueID)
+			}
+			tt.check(t, v)
+
+			events, _ := Store.GetRCAHistory("C-add", issueID)
+			if len(events) != 1 || events[0].Command != "/addrca" {
+				t.Errorf("history = %+v", events)
+			}
+		})
+	}
+}
+
+func TestMemoryStoreDoneRCA(t *testing.T) {
+	useMemoryStore(t)
+	issueID := mustAddRCA(t, "C-done", "(DB down) (desc) @budi")
+
+	if _, err := DoneRCA("budi", "RCA-99", "C-done", StatusDone); err == nil {
+		t.Error("DoneRCA on an unknown ID should fail")
+	}
+
+	if _, err := DoneRCA("budi", issueID, "C-done", StatusDone); err != nil {
+		t.Fatalf("DoneRCA error: %v", err)
+	}
+
+	v, _ := Store.GetRCA("C-done", issueID)
+	if v.Status != StatusDone || v.DoneAt == 0 {
+		t.Errorf("status %v DoneAt %d, want Done with a time", v.Status, v.DoneAt)
+	}
+
+	if _, err := DoneRCA("budi", issueID, "C-done", StatusRemoved); err != nil {
+		t.Fatalf("remove error: %v", err)
+	}
+
+	v, _ = Store.GetRCA("C-done", issueID)
+	if v.Status != StatusRemoved || v.PrevStatus != StatusDone {
+		t.Errorf("status %v prev %v, want Removed from Done", v.Status, v.PrevStatus)
+	}
+
+	events, _ := Store.GetRCAHistory("C-done", issueID)
+	if len(events) != 3 {
+		t.Errorf("history has %d events, want add, done and remove", len(events))
+	}
+}
+
+func TestMemoryStoreSetPMA(t *testing.T) {
+	useMemoryStore(t)
+	issueID := mustAddRCA(t, "C-pma", "(DB down) (desc) @budi")
+
+	if _, err := SetPMA("budi", "C-pma", issueID); err == nil {
+		t.Error("SetPMA without a link should fail")
+	}
+
+	if _, err := SetPMA("budi", "C-pma", issueID+" https://pma.example/7"); err != nil {
+		t.Fatalf("SetPMA error: %v", err)
+	}
+
+	v, _ := Store.GetRCA("C-pma", issueID)
+	if v.PMA != "https://pma.example/7" {
+		t.Errorf("PMA = %q", v.PMA)
+	}
+
+	events, _ := Store.GetRCAHistory("C-pma", issueID)
+	last := events[len(events)-1]
+	if last.Command != "/setpma" || last.OldValue != "" || last.NewValue != "https://pma.example/7" {
+		t.Errorf("last event = %+v", last)
+	}
+}
+
+func TestMemoryStoreScheduler(t *testing.T) {
+	useMemoryStore(t)
+
+	if _, err := SetScheduler("budi", "C-cron", "every day"); err == nil {
+		t.Error("SetScheduler with an invalid schedule should fail")
+	}
+
+	if _, err := SetScheduler("budi", "C-cron", "0 9 * * 1"); err != nil {
+		t.Fatalf("SetScheduler error: %v", err)
+	}
+
+	schedules, _ := Store.GetAllSchedules()
+	if schedules["C-cron"] != "0 9 * * 1" {
+		t.Errorf("schedules = %v", schedules)
+	}
+
+	if _, err := RemoveScheduler("budi", "C-cron"); err != nil {
+		t.Fatalf("RemoveScheduler error: %v", err)
+	}
+
+	schedules, _ = Store.GetAllSchedules()
+	if _, ok := schedules["C-cron"]; ok {
+		t.Errorf("schedule still set: %v", schedules)
+	}
+}
+
+func TestMemoryStoreCopiesValues(t *testing.T) {
+	store := useMemoryStore(t)
+	issueID := mustAddRCA(t, "C-copy", "(DB down) (desc) @budi")
+
+	v, _ := store.GetRCA("C-copy", issueID)
+	v.Title = "changed by caller"
+
+	stored, _ := store.GetRCA("C-copy", issueID)
+	if stored.Title != "DB down" {
+		t.Errorf("caller change leaked into the store: %q", stored.Title)
+	}
+}
+
+func TestMemoryStoreDoneNeedsForceWithOpenActions(t *testing.T) {
+	useMemoryStore(t)
+	issueID := mustAddRCA(t, "C-act", "(DB down) (desc) @budi")
+
+	if _, err := AddAction("budi", "C-act", issueID+" (Add failover alert) @andi"); err != nil {
+		t.Fatalf("AddAction error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		done func() error
+	}{
+		{"donerca", func() error { _, err := DoneRCA("budi", issueID, "C-act", StatusDone); return err }},
+		{"setstatus", func() error { _, err := SetStatus("budi", "C-act", issueID+" done"); return err }},
+		{"bulk", func() error { _, err := DoneRCA("budi", "assignee=@budi", "C-act", StatusDone); return err }},
+	}
+
+	for _, tt := range tests {
+		if err := tt.done(); err == nil {
+			t.Errorf("%s: Done with an open action item should fail", tt.name)
+		}
+	}
+
+	if v, _ := Store.GetRCA("C-act", issueID); v.Status != StatusOpen {
+		t.Fatalf("status = %v, want Open", v.Status)
+	}
+
+	if _, err := SetStatus("budi", "C-act", issueID+" done force"); err != nil {
+		t.Fatalf("SetStatus with force error: %v", err)
+	}
+
+	if v, _ := Store.GetRCA("C-act", issueID); v.Status != StatusDone {
+		t.Errorf("status = %v, want Done", v.Status)
+	}
+}
+
+func TestMemoryStorePurgeRemovedRCA(t *testing.T) {
+	useMemoryStore(t)
+	now := time.Now()
+
+	old := mustAddRCA(t, "C-purge", "(Old) (desc) @budi")
+	legacy := mustAddRCA(t, "C-purge", "(Legacy) (desc) @budi")
+	recent := mustAddRCA(t, "C-purge", "(Recent) (desc) @budi")
+
+	for issueID, removedAt := range map[string]int64{old: now.AddDate(0, 0, -40).Unix(), legacy: 0, recent: now.Unix()} {
+		removedAt := removedAt
+		Store.UpdateRCA("C-purge", issueID, func(data *RCAData) error {
+			data.Status = StatusRemoved
+			data.RemovedAt = removedAt
+			return nil
+		})
+	}
+
+	PurgeRemovedRCA(now, 30)
+
+	ch, _ := Store.GetChannel("C-purge")
+	if _, ok := ch.Data[old]; ok {
+		t.Errorf("%s removed 40 days ago was not purged", old)
+	}
+	if v := ch.Data[legacy]; v.RemovedAt != now.Unix() {
+		t.Errorf("%s RemovedAt = %d, want it stamped with now", legacy, v.RemovedAt)
+	}
+	if _, ok := ch.Data[recent]; !ok {
+		t.Errorf("%s removed today was purged", recent)
+	}
+	if len(ch.Data) != 2 {
+		t.Errorf("channel has %d items, want 2", len(ch.Data))
+	}
+}
+
+func TestValidateExportDataLegacyAssignee(t *testing.T) {
+	data := ExportData{
+		Version: ExportVersion,
+		Channel: map[string]Channel{
+			"C1": {Data: map[string]RCAData{
+				"RCA-1": {Title: "DB down", Status: StatusOpen},
+				"RCA-2": {Title: "Cache miss", Assignee: "@budi", Status: StatusOpen},
+			}},
+		},
+	}
+
+	if errs := ValidateExportData(data); len(errs) != 0 {
+		t.Errorf("ValidateExportData() = %v, want no errors", errs)
+	}
+
+	warns := ValidateExportWarnings(data)
+	if len(warns) != 1 || warns[0].Error() != "Channel/C1/data/RCA-1: Assignee is empty" {
+		t.Errorf("ValidateExportWarnings() = %v, want one warning for RCA-1", warns)
+	}
+}
+
+func TestImportStoreKeepsHigherSeq(t *testing.T) {
+	store := useMemoryStore(t)
+	mustAddRCA(t, "C1", "(DB down) (primary lost) @budi")
+	mustAddRCA(t, "C1", "(Cache miss) (cold start) @budi")
+
+	data := ExportData{
+		Version: ExportVersion,
+		Channel: map[string]Channel{
+			"C1": {Seq: 1, Data: map[string]RCAData{
+				"RCA-1": {Title: "DB down", Assignee: "@budi", Status: StatusOpen},
+			}},
+		},
+	}
+	if err := ImportStore(store, data); err != nil {
+		t.Fatalf("ImportStore error: %v", err)
+	}
+
+	issueID := mustAddRCA(t, "C1", "(Queue stuck) (consumer died) @budi")
+	if issueID != "RCA-3" {
+		t.Errorf("next issue = %s, want RCA-3", issueID)
+	}
+}
+
+func TestCreateRCARefusesExistingID(t *testing.T) {
+	store := useMemoryStore(t)
+	mustAddRCA(t, "C1", "(DB down) (primary lost) @budi")
+
+	if err := store.SetIssueSeq("C1", 0); err != nil {
+		t.Fatalf("SetIssueSeq error: %v", err)
+	}
+
+	if _, err := AddRCA("budi", "(Cache miss) (cold start) @budi", "C1"); err == nil {
+		t.Fatal("AddRCA over RCA-1 succeeded, want an error")
+	}
+
+	v, err := store.GetRCA("C1", "RCA-1")
+	if err != nil {
+		t.Fatalf("GetRCA error: %v", err)
+	}
+	if v.Title != "DB down" {
+		t.Errorf("RCA-1 title = %q, want the original item kept", v.Title)
+	}
+}
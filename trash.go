@@ -0,0 +1,142 @@
+package main
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	cron "github.com/robfig/cron/v3"
+)
+
+const (
+	PurgeCronInterval = "0 3 * * *" //daily
+)
+
+var (
+	purgeCronTask *cron.Cron
+)
+
+func RestoreRCA(uname, text, channelID string) (string, error) {
+	desc := strings.Split(text, " ")
+
+	if len(desc) < 1 || desc[0] == "" {
+		return "", MsgError("restore.invalid")
+	}
+
+	issueID, v, err := FindRCA(channelID, desc[0])
+	if err != nil {
+		return "", err
+	}
+
+	if v.Title == "" {
+		return "", MsgError("rca.invalid_id", desc[0], Msg("action.restore"), uname)
+	}
+
+	restoredTo := StatusOpen
+	err = Store.UpdateRCA(channelID, issueID, func(data *RCAData) error {
+		if data.Title == "" {
+			return ErrRCANotFound
+		}
+
+		if data.Status != StatusRemoved {
+			return MsgError("restore.not_removed", data.Title, data.DisplayID(issueID))
+		}
+
+		data.Status = data.PrevStatus
+		data.PrevStatus = StatusOpen
+		data.RemovedAt = 0
+		restoredTo = data.Status
+		return nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	RecordRCAEvent(channelID, issueID, uname, "/restorerca", "Status", StatusRemoved.String(), restoredTo.String())
+	return T(ChannelLanguage(channelID), "restore.done", v.Title, v.DisplayID(issueID), restoredTo, uname), nil
+}
+
+// GetTrashRetentionDays reads RCA_TRASH_RETENTION_DAYS, zero disables the purge.
+func GetTrashRetentionDays() int {
+	days, err := strconv.Atoi(os.Getenv("RCA_TRASH_RETENTION_DAYS"))
+	if err != nil || days < 0 {
+		return 0
+	}
+
+	return days
+}
+
+func RegisterPurgeCron() {
+	days := GetTrashRetentionDays()
+	if days == 0 {
+		Println(nil, "RCA TRASH RETENTION DISABLED")
+		return
+	}
+
+	c := &Cron{
+		listenErrCh: make(chan error),
+	}
+
+	c.register(Job{
+		Interval: PurgeCronInterval,
+		Handler: func() {
+			PurgeRemovedRCA(time.Now(), days)
+		},
+	})
+
+	purgeCronTask = cron.New()
+	c.Run(purgeCronTask, false)
+}
+
+// PurgeRemovedRCA permanently deletes removed items older than the retention.
+// Items removed before RemovedAt existed get stamped now and are purged once
+// the retention passes from today.
+func PurgeRemovedRCA(now time.Time, days int) {
+	channels, err := GetAllRCAData()
+	if err != nil {
+		Println(nil, "PURGE REMOVED RCA ERROR, err: ", err)
+		return
+	}
+
+	deadline := now.AddDate(0, 0, -days).Unix()
+
+	for channelID, ch := range channels {
+		for issueID, is := range ch.Data {
+			if is.Status != StatusRemoved {
+				continue
+			}
+
+			if is.RemovedAt == 0 {
+				err := Store.UpdateRCA(channelID, issueID, func(data *RCAData) error {
+					//deleted meanwhile, writing would bring back an empty item
+					if data.Title == "" {
+						return ErrRCANotFound
+					}
+
+					if data.Status == StatusRemoved && data.RemovedAt == 0 {
+						data.RemovedAt = now.Unix()
+					}
+					return nil
+				})
+
+				if err != nil && err != ErrRCANotFound {
+					Println(nil, "PURGE REMOVED RCA STAMP ERROR, err: ", err)
+				}
+				continue
+			}
+
+			if is.RemovedAt > deadline {
+				continue
+			}
+
+			if err := Store.DeleteRCA(channelID, issueID); err != nil {
+				Println(nil, "PURGE REMOVED RCA ERROR, err: ", err)
+				continue
+			}
+
+			Println(nil, "PURGED REMOVED RCA: ", channelID, issueID)
+		}
+	}
+}
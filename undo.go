@@ -0,0 +1,210 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultUndoWindowMinutes = 15
+)
+
+// UndoSnapshot is the state of the items one command changed, kept per user
+// and channel so /undorca can put them back. Before lacks the items the
+// command created. Snapshots only live in memory and are lost on restart.
+type UndoSnapshot struct {
+	User      string
+	ChannelID string
+	Command   string
+	Time      time.Time
+	Before    map[string]RCAData
+	After     map[string]RCAData
+}
+
+var (
+	undoMtx       sync.Mutex
+	undoSnapshots = map[string]UndoSnapshot{}    //user/channel -> last snapshot
+	undoTouched   = map[string]map[string]bool{} //user/channel -> items the running command changed
+)
+
+func undoKey(uname, channelID string) string {
+	return uname + "/" + channelID
+}
+
+// GetUndoWindow reads RCA_UNDO_WINDOW_MINUTES, how long a change can be
+// undone.
+func GetUndoWindow() time.Duration {
+	minutes, err := strconv.Atoi(os.Getenv("RCA_UNDO_WINDOW_MINUTES"))
+	if err != nil || minutes <= 0 {
+		minutes = DefaultUndoWindowMinutes
+	}
+
+	return time.Duration(minutes) * time.Minute
+}
+
+// TrackUndo starts collecting the items the user's running command changes,
+// RecordRCAEvent reports each of them through TouchUndo.
+func TrackUndo(uname, channelID string) {
+	undoMtx.Lock()
+	undoTouched[undoKey(uname, channelID)] = map[string]bool{}
+	undoMtx.Unlock()
+}
+
+func TouchUndo(uname, channelID, issueID string) {
+	undoMtx.Lock()
+	if touched, ok := undoTouched[undoKey(uname, channelID)]; ok {
+		touched[issueID] = true
+	}
+	undoMtx.Unlock()
+}
+
+// UntrackUndo stops tracking and returns the items changed since TrackUndo.
+func UntrackUndo(uname, channelID string) map[string]bool {
+	key := undoKey(uname, channelID)
+
+	undoMtx.Lock()
+	defer undoMtx.Unlock()
+
+	touched := undoTouched[key]
+	delete(undoTouched, key)
+	return touched
+}
+
+// RecordUndoSnapshot compares the touched items before a command with their
+// current state and keeps the difference as the user's last undoable change.
+// Changes others made to the rest of the channel meanwhile are left out.
+func RecordUndoSnapshot(uname, channelID, command string, before Channel, touched map[string]bool) {
+	after, err := Store.GetChannel(channelID)
+	if err != nil {
+		Println(nil, "RECORD UNDO GET RCA DATA ERROR, err: ", err)
+		return
+	}
+
+	snap := UndoSnapshot{
+		User:      uname,
+		ChannelID: channelID,
+		Command:   command,
+		Time:      time.Now(),
+		Before:    map[string]RCAData{},
+		After:     map[string]RCAData{},
+	}
+
+	for issueID := range touched {
+		is, ok := after.Data[issueID]
+		if !ok {
+			continue
+		}
+
+		old, existed := before.Data[issueID]
+		if existed && sameRCAData(old, is) {
+			continue
+		}
+
+		if existed {
+			snap.Before[issueID] = old
+		}
+		snap.After[issueID] = is
+	}
+
+	if len(snap.After) == 0 {
+		return
+	}
+
+	undoMtx.Lock()
+	undoSnapshots[undoKey(uname, channelID)] = snap
+	undoMtx.Unlock()
+}
+
+func sameRCAData(a, b RCAData) bool {
+	ja, _ := json.Marshal(a)
+	jb, _ := json.Marshal(b)
+	return string(ja) == string(jb)
+}
+
+// UndoRCA reverts the caller's last change in the channel. It refuses when
+// the window passed or when someone changed one of the items since.
+func UndoRCA(uname, channelID string) (string, error) {
+	key := undoKey(uname, channelID)
+
+	undoMtx.Lock()
+	snap, ok := undoSnapshots[key]
+	undoMtx.Unlock()
+
+	window := GetUndoWindow()
+	if !ok || time.Since(snap.Time) > window {
+		return "", MsgError("undo.nothing", int(window.Minutes()))
+	}
+
+	restore := []string{}
+	remove := []string{}
+	for issueID := range snap.After {
+		if _, existed := snap.Before[issueID]; existed {
+			restore = append(restore, issueID)
+		} else {
+			remove = append(remove, issueID)
+		}
+	}
+	SortIssueKeysByCreated(restore, snap.After)
+	sort.Strings(remove)
+
+	ch, err := Store.GetChannel(channelID)
+	if err != nil {
+		return "", err
+	}
+
+	conflicts := []string{}
+	for issueID, after := range snap.After {
+		if current, ok := ch.Data[issueID]; !ok || !sameRCAData(current, after) {
+			conflicts = append(conflicts, fmt.Sprintf("%s (`%s`)", after.Title, after.DisplayID(issueID)))
+		}
+	}
+
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return "", MsgError("undo.conflict", snap.Command, strings.Join(conflicts, ", "))
+	}
+
+	err = Store.UpdateRCAs(channelID, restore, func(issueID string, data *RCAData) error {
+		if !sameRCAData(*data, snap.After[issueID]) {
+			return MsgError("undo.changed_meanwhile", snap.Command, snap.After[issueID].DisplayID(issueID))
+		}
+
+		*data = snap.Before[issueID]
+		return nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, issueID := range remove {
+		if err := Store.DeleteRCA(channelID, issueID); err != nil {
+			return "", err
+		}
+	}
+
+	undoMtx.Lock()
+	delete(undoSnapshots, key)
+	undoMtx.Unlock()
+
+	lang := ChannelLanguage(channelID)
+	lines := []string{}
+	for _, issueID := range restore {
+		is := snap.Before[issueID]
+		RecordRCANote(channelID, issueID, uname, "/undorca", "reverted", snap.Command)
+		lines = append(lines, T(lang, "undo.restored", is.Title, is.DisplayID(issueID), is.Status))
+	}
+
+	for _, issueID := range remove {
+		is := snap.After[issueID]
+		lines = append(lines, T(lang, "undo.deleted", is.Title, is.DisplayID(issueID)))
+	}
+
+	return T(lang, "undo.done", snap.Command, uname) + "\n" + strings.Join(lines, "\n"), nil
+}
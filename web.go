@@ -42,6 +42,7 @@ func (w *WebServer) RegisterAPI(api APIIntf) {
 func (w *WebServer) Run() {
 	Println(nil, "[!!!] Starting Server at port:", w.Opt.Port)
 	n := negroni.New()
+	n.Use(NewSlackSignatureMiddleware())
 	n.UseHandler(w.router)
 	Fatalln("[!!!] Exiting gracefully... err: ", grace.Serve(w.Opt.Port, n))
 }